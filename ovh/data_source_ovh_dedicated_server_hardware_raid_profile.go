@@ -0,0 +1,145 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func dataSourceDedicatedServerHardwareRaidProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedServerHardwareRaidProfileRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"template_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template name, to compute the size of the hardware RAID",
+				RequiredWith: []string{"partition_scheme_name"},
+			},
+			"partition_scheme_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Partition scheme name, to compute the size of the hardware RAID",
+				RequiredWith: []string{"template_name"},
+			},
+
+			// Computed
+			"controllers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Hardware RAID controllers of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Model of the controller",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the controller",
+						},
+						"disks": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Disks managed by the controller, grouped by kind",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capacity": dedicatedServerSpecificationValueSchema("Capacity of each disk"),
+									"names": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Names of the disks, as used in hardware RAID disks (cX:dY)",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"number": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of disks",
+									},
+									"speed": dedicatedServerSpecificationValueSchema("Speed of the disks"),
+									"technology": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Technology of the disks",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"raid_size": dedicatedServerSpecificationValueSchema("Size of the hardware RAID built by the partition scheme"),
+		},
+	}
+}
+
+func dataSourceDedicatedServerHardwareRaidProfileRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	profile, err := getDedicatedServerHardwareRaidProfile(serviceName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	controllers := make([]map[string]interface{}, len(profile.Controllers))
+	for i, controller := range profile.Controllers {
+		controllers[i] = controller.ToMap()
+	}
+
+	d.SetId(serviceName)
+	d.Set("controllers", controllers)
+
+	templateName := d.Get("template_name").(string)
+	schemeName := d.Get("partition_scheme_name").(string)
+	if templateName != "" && schemeName != "" {
+		size, err := getDedicatedServerHardwareRaidSize(serviceName, templateName, schemeName, config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		d.Set("raid_size", size.Capacity.ToList())
+	}
+
+	return nil
+}
+
+func getDedicatedServerHardwareRaidProfile(serviceName string, c *ovh.Client) (*DedicatedServerHardwareRaidProfile, error) {
+	profile := &DedicatedServerHardwareRaidProfile{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/install/hardwareRaidProfile",
+		url.PathEscape(serviceName),
+	)
+
+	if err := c.Get(endpoint, profile); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return profile, nil
+}
+
+func getDedicatedServerHardwareRaidSize(serviceName, templateName, schemeName string, c *ovh.Client) (*DedicatedServerHardwareRaidSize, error) {
+	size := &DedicatedServerHardwareRaidSize{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/install/hardwareRaidSize?templateName=%s&partitionSchemeName=%s",
+		url.PathEscape(serviceName),
+		url.QueryEscape(templateName),
+		url.QueryEscape(schemeName),
+	)
+
+	if err := c.Get(endpoint, size); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerHardwareRaidProfileDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServerHardwareRaidProfileDatasourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_hardware_raid_profile.profile", "controllers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerHardwareRaidProfileDatasourceConfig() string {
+	dedicated_server := os.Getenv("OVH_DEDICATED_SERVER")
+	return fmt.Sprintf(
+		testAccDedicatedServerHardwareRaidProfileDatasourceConfig_Basic,
+		dedicated_server,
+	)
+}
+
+const testAccDedicatedServerHardwareRaidProfileDatasourceConfig_Basic = `
+data "ovh_dedicated_server_hardware_raid_profile" "profile" {
+  service_name = "%s"
+}
+`
@@ -0,0 +1,72 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func dataSourceDedicatedServerSecondaryDnsDomainToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedServerSecondaryDnsDomainTokenRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain to host as secondary DNS",
+			},
+
+			// Computed
+			"sub_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subdomain of the TXT record holding the token",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ownership validation token",
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerSecondaryDnsDomainTokenRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	domain := d.Get("domain").(string)
+
+	token, err := getDedicatedServerSecondaryDnsNameDomainToken(serviceName, domain, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceName, domain))
+	d.Set("sub_domain", token.SubDomain)
+	d.Set("token", token.Token)
+
+	return nil
+}
+
+func getDedicatedServerSecondaryDnsNameDomainToken(serviceName, domain string, c *ovh.Client) (*DedicatedServerSecondaryDnsNameDomainToken, error) {
+	token := &DedicatedServerSecondaryDnsNameDomainToken{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/secondaryDnsNameDomainToken?domain=%s",
+		url.PathEscape(serviceName),
+		url.QueryEscape(domain),
+	)
+
+	if err := c.Get(endpoint, token); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return token, nil
+}
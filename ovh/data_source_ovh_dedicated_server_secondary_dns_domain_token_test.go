@@ -0,0 +1,41 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerSecondaryDnsDomainTokenDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDedicatedServer(t)
+			testAccPreCheckDomain(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccDedicatedServerSecondaryDnsDomainTokenDatasourceConfig,
+					os.Getenv("OVH_DEDICATED_SERVER"),
+					os.Getenv("OVH_ZONE_TEST"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_secondary_dns_domain_token.token", "sub_domain"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_secondary_dns_domain_token.token", "token"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDedicatedServerSecondaryDnsDomainTokenDatasourceConfig = `
+data "ovh_dedicated_server_secondary_dns_domain_token" "token" {
+  service_name = "%s"
+  domain       = "%s"
+}
+`
@@ -0,0 +1,310 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func dataSourceDedicatedServerSpecifications() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedServerSpecificationsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+
+			// Computed hardware
+			"boot_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server boot mode (legacy, uefi, uefi-legacy)",
+			},
+			"cores_per_processor": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of cores per processor",
+			},
+			"cpu_family": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CPU family",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Commercial description of the server",
+			},
+			"form_factor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server form factor",
+			},
+			"memory_size": dedicatedServerSpecificationValueSchema("RAM size"),
+			"motherboard": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server motherboard",
+			},
+			"number_of_processors": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of processors",
+			},
+			"processor_architecture": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Processor architecture",
+			},
+			"processor_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Processor name",
+			},
+			"threads_per_processor": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of threads per processor",
+			},
+			"disk_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Disk groups of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_hardware_raid_size": dedicatedServerSpecificationValueSchema("Default hardware RAID size"),
+						"default_hardware_raid_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Default hardware RAID type",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human readable description of the disk group",
+						},
+						"disk_group_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Id of the disk group, to be used as install task disk_group_id",
+						},
+						"disk_size": dedicatedServerSpecificationValueSchema("Size of each disk"),
+						"disk_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the disks (NVMe, SAS, SATA, SSD)",
+						},
+						"number_of_disks": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of disks of the group",
+						},
+						"raid_controller": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "RAID controller managing the disks, if any",
+						},
+					},
+				},
+			},
+
+			// Computed network
+			"bandwidth": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Public bandwidth of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"internet_to_ovh": dedicatedServerSpecificationValueSchema("Bandwidth from internet to OVH"),
+						"ovh_to_internet": dedicatedServerSpecificationValueSchema("Bandwidth from OVH to internet"),
+						"ovh_to_ovh":      dedicatedServerSpecificationValueSchema("Bandwidth inside OVH network"),
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Bandwidth offer type",
+						},
+					},
+				},
+			},
+			"connection_speed": dedicatedServerSpecificationValueSchema("Network connection speed"),
+			"ola": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "OVH Link Aggregation capabilities",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether OLA is available on the server",
+						},
+						"available_modes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "OLA modes the server can be switched to",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"supported_modes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "OLA modes supported by the server",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"routing": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Routing of the server main IPs",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4": dedicatedServerSpecificationRoutingIpSchema("IPv4 routing"),
+						"ipv6": dedicatedServerSpecificationRoutingIpSchema("IPv6 routing"),
+					},
+				},
+			},
+			"vrack": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "vRack bandwidth of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bandwidth": dedicatedServerSpecificationValueSchema("vRack bandwidth"),
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "vRack bandwidth offer type",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dedicatedServerSpecificationValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unit": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dedicatedServerSpecificationRoutingIpSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"gateway": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ip": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedServerSpecificationsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	hardware, err := getDedicatedServerHardwareSpecifications(serviceName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	network := &DedicatedServerNetworkSpecifications{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/specifications/network",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Get(endpoint, network); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(serviceName)
+
+	d.Set("boot_mode", hardware.BootMode)
+	d.Set("cpu_family", hardware.CpuFamily)
+	d.Set("memory_size", hardware.MemorySize.ToList())
+	d.Set("processor_architecture", hardware.ProcessorArchitecture)
+
+	if hardware.CoresPerProcessor != nil {
+		d.Set("cores_per_processor", *hardware.CoresPerProcessor)
+	}
+	if hardware.Description != nil {
+		d.Set("description", *hardware.Description)
+	}
+	if hardware.FormFactor != nil {
+		d.Set("form_factor", *hardware.FormFactor)
+	}
+	if hardware.Motherboard != nil {
+		d.Set("motherboard", *hardware.Motherboard)
+	}
+	if hardware.NumberOfProcessors != nil {
+		d.Set("number_of_processors", *hardware.NumberOfProcessors)
+	}
+	if hardware.ProcessorName != nil {
+		d.Set("processor_name", *hardware.ProcessorName)
+	}
+	if hardware.ThreadsPerProcessor != nil {
+		d.Set("threads_per_processor", *hardware.ThreadsPerProcessor)
+	}
+
+	diskGroups := make([]map[string]interface{}, len(hardware.DiskGroups))
+	for i, diskGroup := range hardware.DiskGroups {
+		diskGroups[i] = diskGroup.ToMap()
+	}
+	d.Set("disk_groups", diskGroups)
+
+	d.Set("bandwidth", network.Bandwidth.ToList())
+	d.Set("connection_speed", network.ConnectionVal.ToList())
+	d.Set("ola", network.Ola.ToList())
+	d.Set("routing", network.Routing.ToList())
+	d.Set("vrack", network.Vrack.ToList())
+
+	return nil
+}
+
+func getDedicatedServerHardwareSpecifications(serviceName string, c *ovh.Client) (*DedicatedServerHardwareSpecifications, error) {
+	hardware := &DedicatedServerHardwareSpecifications{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/specifications/hardware",
+		url.PathEscape(serviceName),
+	)
+
+	if err := c.Get(endpoint, hardware); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return hardware, nil
+}
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerSpecificationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServerSpecificationsDatasourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_specifications.spec", "processor_architecture"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_specifications.spec", "memory_size.0.value"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_specifications.spec", "disk_groups.0.disk_group_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_dedicated_server_specifications.spec", "bandwidth.0.type"),
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerSpecificationsDatasourceConfig() string {
+	dedicated_server := os.Getenv("OVH_DEDICATED_SERVER")
+	return fmt.Sprintf(
+		testAccDedicatedServerSpecificationsDatasourceConfig_Basic,
+		dedicated_server,
+	)
+}
+
+const testAccDedicatedServerSpecificationsDatasourceConfig_Basic = `
+data "ovh_dedicated_server_specifications" "spec" {
+  service_name = "%s"
+}
+
+output test { value = tostring(data.ovh_dedicated_server_specifications.spec.disk_groups[0].number_of_disks > 0) }
+`
@@ -2,16 +2,66 @@ package ovh
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
 )
 
+// maximum number of dedicated servers fetched concurrently
+const dedicatedServersFetchWorkers = 8
+
 func dataSourceDedicatedServers() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceDedicatedServersRead,
 		Schema: map[string]*schema.Schema{
+			"commercial_range": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the commercial range of the servers",
+			},
+			"datacenter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the datacenter of the servers",
+			},
+			"os": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the operating system of the servers",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter on the state of the servers (error, hacked, hackedBlocked, ok)",
+			},
+			"name_regexp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Filter on the service name of the servers",
+				ValidateFunc: dedicatedServersValidateRegexp,
+			},
+			"reverse_regexp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Filter on the reverse of the servers",
+				ValidateFunc: dedicatedServersValidateRegexp,
+			},
+			"vrack": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only keep the servers which are members of this vRack",
+			},
+			"fetch_details": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Export the details of the servers",
+			},
+
 			// Computed
 			"result": {
 				Type:     schema.TypeList,
@@ -20,10 +70,152 @@ func dataSourceDedicatedServers() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Details of the servers, when fetch_details is true",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"boot_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"commercial_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "dedicater server commercial range",
+						},
+						"datacenter": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "dedicated datacenter localisation (bhs1,bhs2,...)",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "dedicated server ip (IPv4)",
+						},
+						"link_speed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"monitoring": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Icmp monitoring state",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "dedicated server name",
+						},
+						"os": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operating system",
+						},
+						"professional_use": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Does this server have professional use option",
+						},
+						"rack": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rescue_mail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reverse": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "dedicated server reverse",
+						},
+						"root_device": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "your server id",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "error, hacked, hackedBlocked, ok",
+						},
+						"support_level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Dedicated server support level (critical, fastpath, gs, pro)",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func dedicatedServersValidateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid regexp: %s", k, err))
+	}
+	return
+}
+
+// dedicatedServersFilter holds the filters applied on the details
+// of the servers
+type dedicatedServersFilter struct {
+	CommercialRange string
+	Datacenter      string
+	Os              string
+	State           string
+	ReverseRegexp   *regexp.Regexp
+}
+
+func (f *dedicatedServersFilter) FromResource(d *schema.ResourceData) *dedicatedServersFilter {
+	f.CommercialRange = d.Get("commercial_range").(string)
+	f.Datacenter = d.Get("datacenter").(string)
+	f.Os = d.Get("os").(string)
+	f.State = d.Get("state").(string)
+
+	if v, ok := d.GetOk("reverse_regexp"); ok {
+		f.ReverseRegexp = regexp.MustCompile(v.(string))
+	}
+	return f
+}
+
+// IsEmpty tells whether the servers can be filtered without
+// fetching their details
+func (f *dedicatedServersFilter) IsEmpty() bool {
+	return f.CommercialRange == "" &&
+		f.Datacenter == "" &&
+		f.Os == "" &&
+		f.State == "" &&
+		f.ReverseRegexp == nil
+}
+
+func (f *dedicatedServersFilter) Match(ds *DedicatedServer) bool {
+	if f.CommercialRange != "" && ds.CommercialRange != f.CommercialRange {
+		return false
+	}
+	if f.Datacenter != "" && ds.Datacenter != f.Datacenter {
+		return false
+	}
+	if f.Os != "" && ds.Os != f.Os {
+		return false
+	}
+	if f.State != "" && ds.State != f.State {
+		return false
+	}
+	if f.ReverseRegexp != nil && !f.ReverseRegexp.MatchString(ds.Reverse) {
+		return false
+	}
+	return true
+}
+
 func dataSourceDedicatedServersRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -34,10 +226,76 @@ func dataSourceDedicatedServersRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error calling /dedicated/server:\n\t %q", err)
 	}
 
+	// filters which don't need the details of the servers come first
+	// to save API calls
+	if v, ok := d.GetOk("name_regexp"); ok {
+		nameRegexp := regexp.MustCompile(v.(string))
+		ids = dedicatedServersFilterNames(ids, func(name string) bool {
+			return nameRegexp.MatchString(name)
+		})
+	}
+
+	if v, ok := d.GetOk("vrack"); ok {
+		endpoint := fmt.Sprintf("/vrack/%s/dedicatedServer", url.PathEscape(v.(string)))
+		members := []string{}
+		if err := config.OVHClient.Get(endpoint, &members); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+
+		isMember := make(map[string]bool)
+		for _, member := range members {
+			isMember[member] = true
+		}
+		ids = dedicatedServersFilterNames(ids, func(name string) bool {
+			return isMember[name]
+		})
+	}
+
 	// sort.Strings sorts in place, returns nothing
 	sort.Strings(ids)
 
+	filter := (&dedicatedServersFilter{}).FromResource(d)
+	fetchDetails := d.Get("fetch_details").(bool)
+
+	details := []map[string]interface{}{}
+	if !filter.IsEmpty() || fetchDetails {
+		dss := make([]DedicatedServer, len(ids))
+		err := helpers.ParallelFor(len(ids), dedicatedServersFetchWorkers, func(i int) error {
+			endpoint := fmt.Sprintf("/dedicated/server/%s", url.PathEscape(ids[i]))
+			if err := config.OVHClient.Get(endpoint, &dss[i]); err != nil {
+				return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		ids = []string{}
+		for i := range dss {
+			if !filter.Match(&dss[i]) {
+				continue
+			}
+
+			ids = append(ids, dss[i].Name)
+			if fetchDetails {
+				details = append(details, dss[i].ToMap())
+			}
+		}
+	}
+
 	d.SetId(hashcode.Strings(ids))
 	d.Set("result", ids)
+	d.Set("details", details)
 	return nil
 }
+
+func dedicatedServersFilterNames(names []string, keep func(name string) bool) []string {
+	result := []string{}
+	for _, name := range names {
+		if keep(name) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
@@ -1,6 +1,9 @@
 package ovh
 
 import (
+	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -21,3 +24,81 @@ func TestAccDedicatedServersDataSource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDedicatedServersDataSource_filters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccDedicatedServersDatasourceConfig_Filters,
+					os.Getenv("OVH_DEDICATED_SERVER"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_dedicated_servers.servers", "result.0",
+						"data.ovh_dedicated_server.server", "name",
+					),
+					resource.TestCheckResourceAttr(
+						"data.ovh_dedicated_servers.servers", "details.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_dedicated_servers.servers", "details.0.reverse",
+						"data.ovh_dedicated_server.server", "reverse",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDedicatedServersDatasourceConfig_Filters = `
+data ovh_dedicated_server "server" {
+  service_name = "%s"
+}
+
+data ovh_dedicated_servers "servers" {
+  name_regexp      = "^${replace(data.ovh_dedicated_server.server.name, ".", "\\.")}$"
+  datacenter       = data.ovh_dedicated_server.server.datacenter
+  commercial_range = data.ovh_dedicated_server.server.commercial_range
+  state            = data.ovh_dedicated_server.server.state
+  fetch_details    = true
+}
+`
+
+func TestDedicatedServersFilterMatch(t *testing.T) {
+	ds := &DedicatedServer{
+		Name:            "ns0000.ip-1-2-3.eu",
+		CommercialRange: "ADVANCE-1",
+		Datacenter:      "rbx8",
+		Os:              "debian10_64",
+		Reverse:         "web01.example.com.",
+		State:           "ok",
+	}
+
+	tests := []struct {
+		filter   dedicatedServersFilter
+		expected bool
+	}{
+		{dedicatedServersFilter{}, true},
+		{dedicatedServersFilter{Datacenter: "rbx8", State: "ok"}, true},
+		{dedicatedServersFilter{Datacenter: "gra2"}, false},
+		{dedicatedServersFilter{CommercialRange: "ADVANCE-1", Os: "debian10_64"}, true},
+		{dedicatedServersFilter{State: "error"}, false},
+		{dedicatedServersFilter{ReverseRegexp: regexp.MustCompile(`^web\d+\.`)}, true},
+		{dedicatedServersFilter{ReverseRegexp: regexp.MustCompile(`^db\d+\.`)}, false},
+	}
+
+	for _, test := range tests {
+		if got := test.filter.Match(ds); got != test.expected {
+			t.Errorf("filter %+v: expected %v, got %v", test.filter, test.expected, got)
+		}
+	}
+
+	if !(&dedicatedServersFilter{}).IsEmpty() {
+		t.Errorf("expected an empty filter")
+	}
+}
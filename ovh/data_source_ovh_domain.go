@@ -0,0 +1,172 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDomain() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain name registered at OVH",
+			},
+
+			// Computed
+			"dnssec_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is DNSSEC supported by the registry of the domain",
+			},
+			"glue_record_ipv6_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Are IPv6 glue records supported by the registry of the domain",
+			},
+			"glue_record_multi_ip_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Are glue records with several IPs supported by the registry of the domain",
+			},
+			"last_update": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update of the domain",
+			},
+			"name_server_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name servers type (external, hosted)",
+			},
+			"offer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Domain offer",
+			},
+			"owo_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is whois obfuscation supported by the registry of the domain",
+			},
+			"transfer_lock_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Transfer lock status (locked, locking, unavailable, unlocked, unlocking)",
+			},
+			"whois_owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Contact handle of the owner of the domain",
+			},
+			"contact_admin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Administrative contact handle",
+			},
+			"contact_billing": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Billing contact handle",
+			},
+			"contact_tech": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Technical contact handle",
+			},
+			"creation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the service",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the service",
+			},
+			"renew_automatic": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the service automatically renewed",
+			},
+			"renew_delete_at_expiration": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the service deleted at expiration",
+			},
+			"renew_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Renewal period in months",
+			},
+			"renewal_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Renewal type of the service",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the service",
+			},
+		},
+	}
+}
+
+func dataSourceDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	name := d.Get("name").(string)
+
+	domain := &Domain{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s",
+		url.PathEscape(name),
+	)
+
+	if err := config.OVHClient.Get(endpoint, domain); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	serviceInfos := &ServiceInfos{}
+	endpoint = fmt.Sprintf(
+		"/domain/%s/serviceInfos",
+		url.PathEscape(name),
+	)
+
+	if err := config.OVHClient.Get(endpoint, serviceInfos); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(domain.Domain)
+	d.Set("dnssec_supported", domain.DnssecSupported)
+	d.Set("glue_record_ipv6_supported", domain.GlueRecordIpv6Supported)
+	d.Set("glue_record_multi_ip_supported", domain.GlueRecordMultiIpSupported)
+	d.Set("last_update", domain.LastUpdate)
+	d.Set("name_server_type", domain.NameServerType)
+	d.Set("offer", domain.Offer)
+	d.Set("owo_supported", domain.OwoSupported)
+	d.Set("transfer_lock_status", domain.TransferLockStatus)
+	d.Set("whois_owner", domain.WhoisOwner)
+
+	d.Set("contact_admin", serviceInfos.ContactAdmin)
+	d.Set("contact_billing", serviceInfos.ContactBilling)
+	d.Set("contact_tech", serviceInfos.ContactTech)
+	d.Set("creation", serviceInfos.Creation)
+	d.Set("expiration", serviceInfos.Expiration)
+	d.Set("renewal_type", serviceInfos.RenewalType)
+	d.Set("status", serviceInfos.Status)
+
+	if serviceInfos.Renew != nil {
+		d.Set("renew_automatic", serviceInfos.Renew.Automatic)
+		d.Set("renew_delete_at_expiration", serviceInfos.Renew.DeleteAtExpiration)
+		if serviceInfos.Renew.Period != nil {
+			d.Set("renew_period", *serviceInfos.Renew.Period)
+		}
+	}
+
+	return nil
+}
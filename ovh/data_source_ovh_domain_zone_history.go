@@ -0,0 +1,157 @@
+package ovh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+// maximum number of snapshots fetched concurrently
+const domainZoneHistoryFetchWorkers = 4
+
+func dataSourceDomainZoneHistory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainZoneHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the domain zone",
+			},
+			"creation_date_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list snapshots created after this date",
+			},
+			"creation_date_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list snapshots created before this date",
+			},
+			"fetch_content": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Download the zone file of each snapshot",
+			},
+
+			// Computed
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation date of the snapshot",
+						},
+						"zone_file_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the zone file of the snapshot",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone file of the snapshot",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainZoneHistoryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	fetchContent := d.Get("fetch_content").(bool)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/history",
+		url.PathEscape(zone),
+	)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("creation_date_from"); ok {
+		query.Set("creationDate.from", v.(string))
+	}
+	if v, ok := d.GetOk("creation_date_to"); ok {
+		query.Set("creationDate.to", v.(string))
+	}
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	dates := []string{}
+	if err := config.OVHClient.Get(endpoint, &dates); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	// most recent snapshots first
+	sort.Sort(sort.Reverse(sort.StringSlice(dates)))
+
+	snapshots := make([]map[string]interface{}, len(dates))
+	err := helpers.ParallelFor(len(dates), domainZoneHistoryFetchWorkers, func(i int) error {
+		restorePoint := &DomainZoneRestorePoint{}
+		snapshotEndpoint := fmt.Sprintf(
+			"/domain/zone/%s/history/%s",
+			url.PathEscape(zone),
+			url.PathEscape(dates[i]),
+		)
+
+		if err := config.OVHClient.Get(snapshotEndpoint, restorePoint); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", snapshotEndpoint, err)
+		}
+
+		snapshot := map[string]interface{}{
+			"creation_date": restorePoint.CreationDate,
+			"zone_file_url": restorePoint.ZoneFileUrl,
+		}
+
+		if fetchContent && restorePoint.ZoneFileUrl != "" {
+			content, err := downloadDomainZoneFile(config.OVHClient.Client, restorePoint.ZoneFileUrl)
+			if err != nil {
+				return fmt.Errorf("Error downloading zone file of snapshot %s of zone %s:\n\t %q", dates[i], zone, err)
+			}
+			snapshot["content"] = content
+		}
+
+		snapshots[i] = snapshot
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(hashcode.Strings(append([]string{zone}, dates...)))
+	d.Set("snapshots", snapshots)
+
+	return nil
+}
+
+func downloadDomainZoneFile(client *http.Client, fileUrl string) (string, error) {
+	resp, err := client.Get(fileUrl)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,40 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneHistoryDataSource_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+	config := fmt.Sprintf(testAccDomainZoneHistoryDatasourceConfig, zone)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_history.history", "snapshots.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_history.history", "snapshots.0.creation_date"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_history.history", "snapshots.0.zone_file_url"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_history.history", "snapshots.0.content"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainZoneHistoryDatasourceConfig = `
+data "ovh_domain_zone_history" "history" {
+  zone = "%s"
+}
+`
@@ -0,0 +1,171 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+// maximum number of records fetched concurrently
+const domainZoneRecordsFetchWorkers = 8
+
+func dataSourceDomainZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainZoneRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the domain zone",
+			},
+			"fieldtype": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter records on their type",
+			},
+			"subdomain": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Filter records on their subdomain",
+				ConflictsWith: []string{"apex"},
+			},
+			"apex": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Only return records of the zone apex, i.e. with an empty subdomain",
+				ConflictsWith: []string{"subdomain"},
+			},
+			"target_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter records whose target matches this regular expression",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := regexp.Compile(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q is not a valid regular expression: %s", k, err))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Id of the record",
+						},
+						"subdomain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subdomain of the record",
+						},
+						"fieldtype": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL of the record",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target of the record",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	var targetRegex *regexp.Regexp
+	if v, ok := d.GetOk("target_regex"); ok {
+		targetRegex = regexp.MustCompile(v.(string))
+	}
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/record",
+		url.PathEscape(zone),
+	)
+
+	// fieldType and subDomain are filtered server side
+	query := url.Values{}
+	if v, ok := d.GetOk("fieldtype"); ok {
+		query.Set("fieldType", v.(string))
+	}
+	if v, ok := d.GetOk("subdomain"); ok {
+		query.Set("subDomain", v.(string))
+	}
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	records := make([]*OvhDomainZoneRecord, len(ids))
+	err := helpers.ParallelFor(len(ids), domainZoneRecordsFetchWorkers, func(i int) error {
+		record := &OvhDomainZoneRecord{}
+		recordEndpoint := fmt.Sprintf(
+			"/domain/zone/%s/record/%d",
+			url.PathEscape(zone),
+			ids[i],
+		)
+
+		if err := config.OVHClient.Get(recordEndpoint, record); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", recordEndpoint, err)
+		}
+
+		records[i] = record
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// apex and target are filtered client side,
+	// the API ignores an empty subDomain
+	apex := d.Get("apex").(bool)
+	mapRecords := []map[string]interface{}{}
+	hashIds := []string{}
+	for _, record := range records {
+		if apex && record.SubDomain != "" {
+			continue
+		}
+		if targetRegex != nil && !targetRegex.MatchString(record.Target) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Found %s", record)
+		mapRecords = append(mapRecords, record.ToMap())
+		hashIds = append(hashIds, strconv.FormatInt(record.Id, 10))
+	}
+
+	d.SetId(hashcode.Strings(append([]string{zone}, hashIds...)))
+	d.Set("records", mapRecords)
+
+	return nil
+}
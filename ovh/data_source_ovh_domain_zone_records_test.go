@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneRecordsDataSource_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(testAccDomainZoneRecordsDatasourceConfig, zone, subdomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.txt", "records.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.txt", "records.0.subdomain", subdomain),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.txt", "records.0.fieldtype", "TXT"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.verification", "records.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_domain_zone_records.verification", "records.0.id",
+						"ovh_domain_zone_record.verification", "id",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_records.apex", "records.0.target"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.apex", "records.0.subdomain", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainZoneRecordsDatasourceConfig = `
+locals {
+  zone      = "%s"
+  subdomain = "%s"
+}
+
+resource "ovh_domain_zone_record" "verification" {
+  zone      = local.zone
+  subdomain = local.subdomain
+  fieldtype = "TXT"
+  target    = "\"site-verification=terraform\""
+}
+
+resource "ovh_domain_zone_record" "spf" {
+  zone      = local.zone
+  subdomain = local.subdomain
+  fieldtype = "TXT"
+  target    = "\"v=spf1 -all\""
+}
+
+data "ovh_domain_zone_records" "txt" {
+  zone      = local.zone
+  subdomain = local.subdomain
+  fieldtype = "TXT"
+
+  depends_on = [
+    ovh_domain_zone_record.verification,
+    ovh_domain_zone_record.spf,
+  ]
+}
+
+data "ovh_domain_zone_records" "verification" {
+  zone         = local.zone
+  subdomain    = local.subdomain
+  fieldtype    = "TXT"
+  target_regex = "site-verification="
+
+  depends_on = [
+    ovh_domain_zone_record.verification,
+    ovh_domain_zone_record.spf,
+  ]
+}
+
+data "ovh_domain_zone_records" "apex" {
+  zone      = local.zone
+  fieldtype = "NS"
+  apex      = true
+}
+`
@@ -0,0 +1,91 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func dataSourceIpService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpServiceRead,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP block",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"campus": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Campus of the IP block",
+			},
+			"can_be_terminated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Country of the IP block",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the IP block",
+			},
+			"organisation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"routed_to": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Service the IP block is routed to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the service",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the IP block",
+			},
+		},
+	}
+}
+
+func dataSourceIpServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	ip := &Ip{}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(block))
+	if err := config.OVHClient.Get(endpoint, ip); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(ip.Ip)
+	for k, v := range ip.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpServiceDataSource_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpServiceDatasourceConfig, block),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_service.block", "ip", block),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_service.block", "type"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_service.block", "routed_to.0.service_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpServiceDatasourceConfig = `
+data "ovh_ip_service" "block" {
+  ip = "%s"
+}
+`
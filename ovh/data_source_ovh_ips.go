@@ -0,0 +1,107 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+const ipsFetchWorkers = 8
+
+func dataSourceIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the IP blocks by type",
+			},
+			"routed_to_service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the IP blocks by the service they are routed to",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the IP blocks by description, ignoring ovh_ip_block_allocation entries",
+			},
+
+			// Computed
+			"result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("type"); ok {
+		query.Set("type", v.(string))
+	}
+	if v, ok := d.GetOk("routed_to_service_name"); ok {
+		query.Set("routedTo.serviceName", v.(string))
+	}
+
+	endpoint := "/ip"
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ips := []string{}
+	if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	// description is filtered client side, as allocations recorded by
+	// ovh_ip_block_allocation are appended to the description of the block
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		matches := make([]bool, len(ips))
+		err := helpers.ParallelFor(len(ips), ipsFetchWorkers, func(i int) error {
+			ip, err := getIp(ips[i], config)
+			if err != nil {
+				return err
+			}
+			matches[i] = ipsDescriptionMatch(ip.Description, description)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		filtered := []string{}
+		for i, ip := range ips {
+			if matches[i] {
+				filtered = append(filtered, ip)
+			}
+		}
+		ips = filtered
+	}
+
+	// sort.Strings sorts in place, returns nothing
+	sort.Strings(ips)
+
+	d.SetId(hashcode.Strings(append([]string{endpoint, d.Get("description").(string)}, ips...)))
+	d.Set("result", ips)
+	return nil
+}
+
+// ipsDescriptionMatch compares the description of a block, without
+// the allocations recorded by ovh_ip_block_allocation
+func ipsDescriptionMatch(description *string, want string) bool {
+	text, _ := ipBlockAllocationParse(description)
+	return text == want
+}
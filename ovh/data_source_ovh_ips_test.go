@@ -0,0 +1,79 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func TestAccIpsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCredentials(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: "data ovh_ips ips {}",
+				Check: resource.TestCheckResourceAttrSet(
+					"data.ovh_ips.ips",
+					"result.#",
+				),
+			},
+		},
+	})
+}
+
+func TestAccIpsDataSource_filters(t *testing.T) {
+	config := fmt.Sprintf(testAccIpsDatasourceConfig, os.Getenv("OVH_IP_BLOCK"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ips.filtered", "result.#"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_ips.filtered", "type",
+						"data.ovh_ip_service.block", "type",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestIpsDescriptionMatch(t *testing.T) {
+	tests := []struct {
+		description *string
+		want        string
+		match       bool
+	}{
+		{nil, "frontends", false},
+		{helpers.GetNilStringPointer("frontends"), "frontends", true},
+		{helpers.GetNilStringPointer("frontends tf-alloc:192.0.2.1=web"), "frontends", true},
+		{helpers.GetNilStringPointer("tf-alloc:192.0.2.1=web"), "frontends", false},
+		{helpers.GetNilStringPointer("backends tf-alloc:192.0.2.1=web"), "frontends", false},
+	}
+
+	for i, test := range tests {
+		if v := ipsDescriptionMatch(test.description, test.want); v != test.match {
+			t.Errorf("test %d: expected match %t, got %t", i, test.match, v)
+		}
+	}
+}
+
+const testAccIpsDatasourceConfig = `
+data "ovh_ip_service" "block" {
+  ip = "%s"
+}
+
+data "ovh_ips" "filtered" {
+  type                   = data.ovh_ip_service.block.type
+  routed_to_service_name = data.ovh_ip_service.block.routed_to[0].service_name
+}
+`
@@ -15,55 +15,134 @@ func waitForDedicatedServerTask(serviceName string, task *DedicatedServerTask, c
 	taskId := task.Id
 
 	refreshFunc := func() (interface{}, string, error) {
-		var taskErr error
-		var task *DedicatedServerTask
-
-		// The Dedicated Server API often returns 500/404 errors
-		// in such case we retry to retrieve task status
-		// 404 may happen because of some inconsistency between the
-		// api endpoint call and the target region executing the task
-		retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			var err error
-			task, err = getDedicatedServerTask(serviceName, taskId, c)
-			if err != nil {
-				if err.(*ovh.APIError).Code == 500 || err.(*ovh.APIError).Code == 404 {
-					// retry
-					return resource.RetryableError(err)
+		task, err := getDedicatedServerTaskWithRetry(serviceName, taskId, c)
+		if err != nil {
+			return taskId, "", err
+		}
+
+		log.Printf("[INFO] Pending Task id %d on Dedicated %s status: %s", taskId, serviceName, task.Status)
+		return taskId, task.Status, nil
+	}
+
+	log.Printf("[INFO] Waiting for Dedicated Server Task id %s/%d", serviceName, taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    45 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Dedicated Server task %s/%d to complete: %s", serviceName, taskId, err)
+	}
+
+	return nil
+}
+
+// waitForDedicatedServerInstallTask waits for an install task to complete,
+// following the progress of the installation steps. It returns the last
+// known steps, which are reported on failure.
+func waitForDedicatedServerInstallTask(serviceName string, task *DedicatedServerTask, timeout time.Duration, c *ovh.Client) ([]DedicatedServerInstallProgressStep, error) {
+	taskId := task.Id
+	progress := []DedicatedServerInstallProgressStep{}
+
+	refreshFunc := func() (interface{}, string, error) {
+		task, err := getDedicatedServerTaskWithRetry(serviceName, taskId, c)
+		if err != nil {
+			return taskId, "", err
+		}
+
+		// the install status is only available while the installation runs.
+		// It is informational, the task status alone decides of the outcome:
+		// errors, often transient, keep the previous progress
+		status, err := getDedicatedServerInstallStatus(serviceName, c)
+		if err != nil {
+			log.Printf("[WARN] Could not read install status of Dedicated Server %s: %s", serviceName, err)
+		}
+		if status != nil {
+			for i, step := range status.Progress {
+				if i >= len(progress) || progress[i] != step {
+					log.Printf(
+						"[INFO] Install of Dedicated Server %s step %d/%d %q: %s (elapsed %ds)",
+						serviceName,
+						i+1,
+						len(status.Progress),
+						step.Comment,
+						step.Status,
+						status.ElapsedTime,
+					)
 				}
-				// other error dont retry and fail
-				taskErr = err
 			}
-			return nil
-		})
+			progress = status.Progress
+		}
 
-		if retryErr != nil {
-			return taskId, "", retryErr
+		for _, step := range progress {
+			if step.Status == "error" {
+				return taskId, task.Status, fmt.Errorf("install step %q failed: %s", step.Comment, step.Error)
+			}
 		}
 
-		if taskErr != nil {
-			return taskId, "", taskErr
+		switch task.Status {
+		case "cancelled", "customerError", "ovhError":
+			return taskId, task.Status, fmt.Errorf("task %s is in state %s: %s", task.Function, task.Status, task.Comment)
 		}
 
 		log.Printf("[INFO] Pending Task id %d on Dedicated %s status: %s", taskId, serviceName, task.Status)
 		return taskId, task.Status, nil
 	}
 
-	log.Printf("[INFO] Waiting for Dedicated Server Task id %s/%d", serviceName, taskId)
+	log.Printf("[INFO] Waiting for Dedicated Server Install Task id %s/%d", serviceName, taskId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"init", "todo", "doing"},
 		Target:     []string{"done"},
 		Refresh:    refreshFunc,
-		Timeout:    45 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
 
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Dedicated Server task %s/%d to complete: %s", serviceName, taskId, err)
+		return progress, fmt.Errorf("Error waiting for Dedicated Server install task %s/%d to complete: %s", serviceName, taskId, err)
 	}
 
-	return nil
+	return progress, nil
+}
+
+func getDedicatedServerTaskWithRetry(serviceName string, taskId int64, c *ovh.Client) (*DedicatedServerTask, error) {
+	var taskErr error
+	var task *DedicatedServerTask
+
+	// The Dedicated Server API often returns 500/404 errors
+	// in such case we retry to retrieve task status
+	// 404 may happen because of some inconsistency between the
+	// api endpoint call and the target region executing the task
+	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		task, err = getDedicatedServerTask(serviceName, taskId, c)
+		if err != nil {
+			if err.(*ovh.APIError).Code == 500 || err.(*ovh.APIError).Code == 404 {
+				// retry
+				return resource.RetryableError(err)
+			}
+			// other error dont retry and fail
+			taskErr = err
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	if taskErr != nil {
+		return nil, taskErr
+	}
+
+	return task, nil
 }
 
 func getDedicatedServerTask(serviceName string, taskId int64, c *ovh.Client) (*DedicatedServerTask, error) {
@@ -80,3 +159,21 @@ func getDedicatedServerTask(serviceName string, taskId int64, c *ovh.Client) (*D
 
 	return task, nil
 }
+
+// getDedicatedServerInstallStatus returns nil when no installation is running
+func getDedicatedServerInstallStatus(serviceName string, c *ovh.Client) (*DedicatedServerInstallStatus, error) {
+	status := &DedicatedServerInstallStatus{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/install/status",
+		url.PathEscape(serviceName),
+	)
+
+	if err := c.Get(endpoint, status); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func waitForDomainTask(domain string, task *DomainTask, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/domain/%s/task/%d",
+		url.PathEscape(domain),
+		task.Id,
+	)
+
+	log.Printf("[INFO] Waiting for Domain Task id %s/%d", domain, task.Id)
+
+	if err := waitForDomainTaskEndpoint(endpoint, c); err != nil {
+		return fmt.Errorf("Error waiting for Domain task %s/%d to complete: %s", domain, task.Id, err)
+	}
+
+	return nil
+}
+
+func waitForDomainZoneTask(zone string, task *DomainTask, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/task/%d",
+		url.PathEscape(zone),
+		task.Id,
+	)
+
+	log.Printf("[INFO] Waiting for Domain Zone Task id %s/%d", zone, task.Id)
+
+	if err := waitForDomainTaskEndpoint(endpoint, c); err != nil {
+		return fmt.Errorf("Error waiting for Domain Zone task %s/%d to complete: %s", zone, task.Id, err)
+	}
+
+	return nil
+}
+
+// domain and domain zone tasks share the same model
+func waitForDomainTaskEndpoint(endpoint string, c *ovh.Client) error {
+	refreshFunc := func() (interface{}, string, error) {
+		task := &DomainTask{}
+		if err := c.Get(endpoint, task); err != nil {
+			return nil, "", err
+		}
+
+		switch task.Status {
+		case "error", "cancelled":
+			comment := ""
+			if task.Comment != nil {
+				comment = *task.Comment
+			}
+			return task, task.Status, fmt.Errorf("task %s is in state %s: %s", task.Function, task.Status, comment)
+		}
+
+		log.Printf("[INFO] Pending Task %s status: %s", endpoint, task.Status)
+		return task, task.Status, nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    20 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func getDomainZoneTask(zone string, taskId int64, c *ovh.Client) (*DomainTask, error) {
+	task := &DomainTask{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/task/%d",
+		url.PathEscape(zone),
+		taskId,
+	)
+
+	if err := c.Get(endpoint, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
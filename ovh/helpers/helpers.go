@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/go-ovh/ovh"
@@ -349,3 +350,31 @@ func GetVrackServiceName(d *schema.ResourceData) (string, error) {
 
 	return *serviceNamePtr, nil
 }
+
+// ParallelFor calls f for each index in [0, count) with at most workers
+// concurrent calls, and returns the first error encountered.
+func ParallelFor(count int, workers int, f func(i int) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	sem := make(chan struct{}, workers)
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := f(i); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	return firstErr
+}
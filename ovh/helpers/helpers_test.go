@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelFor(t *testing.T) {
+	results := make([]int, 50)
+	var running, maxRunning int32
+
+	err := ParallelFor(len(results), 4, func(i int) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		results[i] = i * i
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if maxRunning > 4 {
+		t.Fatalf("expected at most 4 concurrent calls, got %d", maxRunning)
+	}
+	for i, v := range results {
+		if v != i*i {
+			t.Fatalf("bad result at %d: %d", i, v)
+		}
+	}
+}
+
+func TestParallelFor_error(t *testing.T) {
+	err := ParallelFor(10, 3, func(i int) error {
+		if i == 7 {
+			return fmt.Errorf("failed on %d", i)
+		}
+		return nil
+	})
+
+	if err == nil || err.Error() != "failed on 7" {
+		t.Fatalf("expected error from index 7, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainZoneRecord_importBasic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhDomainZoneRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOvhDomainZoneRecordConfig_A(zone, subdomain, "192.168.0.10", 3600),
+			},
+			{
+				ResourceName:            "ovh_domain_zone_record.foobar",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s/A/192.168.0.10", zone, subdomain),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func TestAccDomainZoneRecord_adoptExisting(t *testing.T) {
+	var record OvhDomainZoneRecord
+	zone := os.Getenv("OVH_ZONE_TEST")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+	existing := &OvhDomainZoneRecord{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhDomainZoneRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				// create the record outside of terraform
+				PreConfig: func() {
+					err := testAccOVHClient.Post(
+						fmt.Sprintf("/domain/zone/%s/record", zone),
+						&OvhDomainZoneRecord{
+							FieldType: "A",
+							SubDomain: subdomain,
+							Target:    "192.168.0.10",
+							Ttl:       3600,
+						},
+						existing,
+					)
+					if err != nil {
+						t.Fatalf("Error creating record: %s", err)
+					}
+				},
+				Config: fmt.Sprintf(testAccDomainZoneRecordConfig_adopt, zone, subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhDomainZoneRecordExists("ovh_domain_zone_record.adopted", &record),
+					func(s *terraform.State) error {
+						if existing.Id != 0 && record.Id != existing.Id {
+							return fmt.Errorf("Expected record %d to be adopted, got %d", existing.Id, record.Id)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_record.adopted", "ttl", "600"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainZoneRecordConfig_adopt = `
+resource "ovh_domain_zone_record" "adopted" {
+	zone           = "%s"
+	subdomain      = "%s"
+	target         = "192.168.0.10"
+	fieldtype      = "A"
+	ttl            = 600
+	adopt_existing = true
+}
+`
@@ -0,0 +1,67 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func waitForIpTask(block string, task *IpTask, c *ovh.Client) error {
+	taskId := task.Id
+
+	refreshFunc := func() (interface{}, string, error) {
+		task, err := getIpTask(block, taskId, c)
+		if err != nil {
+			return taskId, "", err
+		}
+
+		switch task.Status {
+		case "cancelled", "customerError", "ovhError":
+			comment := ""
+			if task.Comment != nil {
+				comment = *task.Comment
+			}
+			return taskId, task.Status, fmt.Errorf("task %s is in state %s: %s", task.Function, task.Status, comment)
+		}
+
+		log.Printf("[INFO] Pending Task id %d on IP %s status: %s", taskId, block, task.Status)
+		return taskId, task.Status, nil
+	}
+
+	log.Printf("[INFO] Waiting for IP Task id %s/%d", block, taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    20 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for IP task %s/%d to complete: %s", block, taskId, err)
+	}
+
+	return nil
+}
+
+func getIpTask(block string, taskId int64, c *ovh.Client) (*IpTask, error) {
+	task := &IpTask{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/task/%d",
+		url.PathEscape(block),
+		taskId,
+	)
+
+	if err := c.Get(endpoint, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
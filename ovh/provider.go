@@ -40,29 +40,37 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ovh_cloud_project_region":             dataSourceCloudProjectRegion(),
-			"ovh_cloud_project_regions":            dataSourceCloudProjectRegions(),
-			"ovh_dedicated_ceph":                   dataSourceDedicatedCeph(),
-			"ovh_dedicated_installation_templates": dataSourceDedicatedInstallationTemplates(),
-			"ovh_dedicated_server":                 dataSourceDedicatedServer(),
-			"ovh_dedicated_server_boots":           dataSourceDedicatedServerBoots(),
-			"ovh_dedicated_servers":                dataSourceDedicatedServers(),
-			"ovh_domain_zone":                      dataSourceDomainZone(),
-			"ovh_iploadbalancing":                  dataSourceIpLoadbalancing(),
-			"ovh_iploadbalancing_vrack_network":    dataSourceIpLoadbalancingVrackNetwork(),
-			"ovh_iploadbalancing_vrack_networks":   dataSourceIpLoadbalancingVrackNetworks(),
-			"ovh_me_identity_user":                 dataSourceMeIdentityUser(),
-			"ovh_me_identity_users":                dataSourceMeIdentityUsers(),
-			"ovh_me_installation_template":         dataSourceMeInstallationTemplate(),
-			"ovh_me_installation_templates":        dataSourceMeInstallationTemplates(),
-			"ovh_me_ipxe_script":                   dataSourceMeIpxeScript(),
-			"ovh_me_ipxe_scripts":                  dataSourceMeIpxeScripts(),
-			"ovh_me_paymentmean_bankaccount":       dataSourceMePaymentmeanBankaccount(),
-			"ovh_me_paymentmean_creditcard":        dataSourceMePaymentmeanCreditcard(),
-			"ovh_me_ssh_key":                       dataSourceMeSshKey(),
-			"ovh_me_ssh_keys":                      dataSourceMeSshKeys(),
-			"ovh_vps":                              dataSourceVPS(),
-			"ovh_vracks":                           dataSourceVracks(),
+			"ovh_cloud_project_region":                        dataSourceCloudProjectRegion(),
+			"ovh_cloud_project_regions":                       dataSourceCloudProjectRegions(),
+			"ovh_dedicated_ceph":                              dataSourceDedicatedCeph(),
+			"ovh_dedicated_installation_templates":            dataSourceDedicatedInstallationTemplates(),
+			"ovh_dedicated_server":                            dataSourceDedicatedServer(),
+			"ovh_dedicated_server_boots":                      dataSourceDedicatedServerBoots(),
+			"ovh_dedicated_server_hardware_raid_profile":      dataSourceDedicatedServerHardwareRaidProfile(),
+			"ovh_dedicated_server_secondary_dns_domain_token": dataSourceDedicatedServerSecondaryDnsDomainToken(),
+			"ovh_dedicated_server_specifications":             dataSourceDedicatedServerSpecifications(),
+			"ovh_dedicated_servers":                           dataSourceDedicatedServers(),
+			"ovh_domain":                                      dataSourceDomain(),
+			"ovh_domain_zone":                                 dataSourceDomainZone(),
+			"ovh_domain_zone_history":                         dataSourceDomainZoneHistory(),
+			"ovh_domain_zone_records":                         dataSourceDomainZoneRecords(),
+			"ovh_ip_service":                                  dataSourceIpService(),
+			"ovh_ips":                                         dataSourceIps(),
+			"ovh_iploadbalancing":                             dataSourceIpLoadbalancing(),
+			"ovh_iploadbalancing_vrack_network":               dataSourceIpLoadbalancingVrackNetwork(),
+			"ovh_iploadbalancing_vrack_networks":              dataSourceIpLoadbalancingVrackNetworks(),
+			"ovh_me_identity_user":                            dataSourceMeIdentityUser(),
+			"ovh_me_identity_users":                           dataSourceMeIdentityUsers(),
+			"ovh_me_installation_template":                    dataSourceMeInstallationTemplate(),
+			"ovh_me_installation_templates":                   dataSourceMeInstallationTemplates(),
+			"ovh_me_ipxe_script":                              dataSourceMeIpxeScript(),
+			"ovh_me_ipxe_scripts":                             dataSourceMeIpxeScripts(),
+			"ovh_me_paymentmean_bankaccount":                  dataSourceMePaymentmeanBankaccount(),
+			"ovh_me_paymentmean_creditcard":                   dataSourceMePaymentmeanCreditcard(),
+			"ovh_me_ssh_key":                                  dataSourceMeSshKey(),
+			"ovh_me_ssh_keys":                                 dataSourceMeSshKeys(),
+			"ovh_vps":                                         dataSourceVPS(),
+			"ovh_vracks":                                      dataSourceVracks(),
 
 			// Legacy naming schema (ovh_cloud)
 			"ovh_cloud_region": deprecated(dataSourceCloudProjectRegion(),
@@ -76,12 +84,39 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_network_private_subnet":                    resourceCloudProjectNetworkPrivateSubnet(),
 			"ovh_cloud_project_user":                                      resourceCloudProjectUser(),
 			"ovh_dedicated_ceph_acl":                                      resourceDedicatedCephACL(),
+			"ovh_dedicated_server_backup_storage":                         resourceDedicatedServerBackupStorage(),
+			"ovh_dedicated_server_backup_storage_access":                  resourceDedicatedServerBackupStorageAccess(),
 			"ovh_dedicated_server_install_task":                           resourceDedicatedServerInstallTask(),
+			"ovh_dedicated_server_ipmi_access":                            resourceDedicatedServerIpmiAccess(),
+			"ovh_dedicated_server_ipmi_reset_task":                        resourceDedicatedServerIpmiResetTask(),
+			"ovh_dedicated_server_networking":                             resourceDedicatedServerNetworking(),
 			"ovh_dedicated_server_reboot_task":                            resourceDedicatedServerRebootTask(),
+			"ovh_dedicated_server_rescue":                                 resourceDedicatedServerRescue(),
+			"ovh_dedicated_server_secondary_dns_domain":                   resourceDedicatedServerSecondaryDnsDomain(),
+			"ovh_dedicated_server_service_monitoring":                     resourceDedicatedServerServiceMonitoring(),
+			"ovh_dedicated_server_service_monitoring_alert":               resourceDedicatedServerServiceMonitoringAlert(),
 			"ovh_dedicated_server_update":                                 resourceDedicatedServerUpdate(),
+			"ovh_dedicated_server_virtual_mac":                            resourceDedicatedServerVirtualMac(),
+			"ovh_domain_glue_record":                                      resourceDomainGlueRecord(),
+			"ovh_domain_name_servers":                                     resourceDomainNameServers(),
+			"ovh_domain_renew":                                            resourceDomainRenew(),
+			"ovh_domain_zone":                                             resourceDomainZone(),
+			"ovh_domain_zone_dynhost_login":                               resourceDomainZoneDynHostLogin(),
+			"ovh_domain_zone_dynhost_record":                              resourceDomainZoneDynHostRecord(),
 			"ovh_domain_zone_record":                                      resourceOvhDomainZoneRecord(),
 			"ovh_domain_zone_redirection":                                 resourceOvhDomainZoneRedirection(),
+			"ovh_domain_zone_restore":                                     resourceDomainZoneRestore(),
+			"ovh_domain_zone_soa":                                         resourceDomainZoneSoa(),
+			"ovh_ip_block_allocation":                                     resourceIpBlockAllocation(),
+			"ovh_ip_firewall":                                             resourceIpFirewall(),
+			"ovh_ip_firewall_rule":                                        resourceIpFirewallRule(),
+			"ovh_ip_game":                                                 resourceIpGame(),
+			"ovh_ip_game_rule":                                            resourceIpGameRule(),
+			"ovh_ip_mitigation":                                           resourceIpMitigation(),
+			"ovh_ip_mitigation_profile":                                   resourceIpMitigationProfile(),
+			"ovh_ip_move":                                                 resourceIpMove(),
 			"ovh_ip_reverse":                                              resourceOvhIpReverse(),
+			"ovh_ip_reverses":                                             resourceIpReverses(),
 			"ovh_iploadbalancing_http_farm":                               resourceIpLoadbalancingHttpFarm(),
 			"ovh_iploadbalancing_http_farm_server":                        resourceIpLoadbalancingHttpFarmServer(),
 			"ovh_iploadbalancing_http_frontend":                           resourceIpLoadbalancingHttpFrontend(),
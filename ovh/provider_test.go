@@ -78,6 +78,23 @@ func testAccPreCheckIp(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_IP_REVERSE")
 }
 
+// Checks that the environment variables needed for the /ip game acceptance tests
+// are set.
+func testAccPreCheckIpGame(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_IP_GAME_BLOCK")
+	checkEnvOrSkip(t, "OVH_IP_GAME")
+}
+
+// Checks that the environment variables needed for the /ip move acceptance tests
+// are set.
+func testAccPreCheckIpMove(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_IP_MOVE_BLOCK")
+	checkEnvOrSkip(t, "OVH_IP_MOVE_SERVICE_NAME_1")
+	checkEnvOrSkip(t, "OVH_IP_MOVE_SERVICE_NAME_2")
+}
+
 // Checks that the environment variables needed for the /domain acceptance tests
 // are set.
 func testAccPreCheckDomain(t *testing.T) {
@@ -118,6 +135,30 @@ func testAccPreCheckDedicatedServer(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_DEDICATED_SERVER")
 }
 
+// Checks that the environment variables needed for the dedicated server
+// virtual MAC acceptance tests are set. OVH_VIRTUAL_MAC_IP must be a failover
+// IP routed to OVH_DEDICATED_SERVER.
+func testAccPreCheckDedicatedServerVirtualMac(t *testing.T) {
+	testAccPreCheckDedicatedServer(t)
+	checkEnvOrSkip(t, "OVH_VIRTUAL_MAC_IP")
+}
+
+// Checks that the environment variables needed for the dedicated server
+// IPMI acceptance tests are set. OVH_IPMI_IP_TO_ALLOW is the IP the console
+// is opened to.
+func testAccPreCheckDedicatedServerIpmi(t *testing.T) {
+	testAccPreCheckDedicatedServer(t)
+	checkEnvOrSkip(t, "OVH_IPMI_IP_TO_ALLOW")
+}
+
+// Checks that the environment variables needed for the dedicated server
+// networking acceptance tests are set. OVH_DEDICATED_SERVER_OLA must be a
+// dedicated server with OLA available and at least two vrack NICs.
+func testAccPreCheckDedicatedServerNetworking(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_DEDICATED_SERVER_OLA")
+}
+
 func testAccPreCheckVPS(t *testing.T) {
 	testAccPreCheckCredentials(t)
 	checkEnvOrSkip(t, "OVH_VPS")
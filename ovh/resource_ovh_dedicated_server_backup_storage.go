@@ -0,0 +1,157 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerBackupStorage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerBackupStorageCreate,
+		Read:   resourceDedicatedServerBackupStorageRead,
+		Update: resourceDedicatedServerBackupStorageUpdate,
+		Delete: resourceDedicatedServerBackupStorageDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("service_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"password_keepers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Change this value to rotate the backup storage password. The new password is sent by email",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			//Computed
+			"ftp_backup_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The backup storage host name",
+			},
+			"quota": dedicatedServerSpecificationValueSchema("The backup storage quota"),
+			"read_only_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date from which the backup storage is read only",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The backup storage type",
+			},
+			"usage": dedicatedServerSpecificationValueSchema("The backup storage usage"),
+		},
+	}
+}
+
+func resourceDedicatedServerBackupStorageCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(serviceName)
+
+	return resourceDedicatedServerBackupStorageRead(d, meta)
+}
+
+func resourceDedicatedServerBackupStorageRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	backup := &DedicatedServerBackupFtp{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Get(endpoint, backup); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("service_name", serviceName)
+	d.Set("ftp_backup_name", backup.FtpBackupName)
+	d.Set("quota", backup.Quota.ToList())
+	d.Set("type", backup.Type)
+	d.Set("usage", backup.Usage.ToList())
+
+	if backup.ReadOnlyDate != nil {
+		d.Set("read_only_date", *backup.ReadOnlyDate)
+	}
+
+	return nil
+}
+
+func resourceDedicatedServerBackupStorageUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	if d.HasChange("password_keepers") {
+		task := &DedicatedServerTask{}
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/features/backupFTP/password",
+			url.PathEscape(serviceName),
+		)
+
+		log.Printf("[INFO] Rotating backup storage password of dedicated server %s", serviceName)
+		if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+			return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+		}
+
+		if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceDedicatedServerBackupStorageRead(d, meta)
+}
+
+func resourceDedicatedServerBackupStorageDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,180 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerBackupStorageAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerBackupStorageAccessCreate,
+		Read:   resourceDedicatedServerBackupStorageAccessRead,
+		Update: resourceDedicatedServerBackupStorageAccessUpdate,
+		Delete: resourceDedicatedServerBackupStorageAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerBackupStorageAccessImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"ip_block": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block allowed to access the backup storage",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"cifs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow CIFS access",
+			},
+			"ftp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Allow FTP access",
+			},
+			"nfs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow NFS access",
+			},
+
+			//Computed
+			"is_applied": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the access is applied on the backup storage",
+			},
+			"last_update": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update of the access",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerBackupStorageAccessImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/IP_BLOCK formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	d.Set("ip_block", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerBackupStorageAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&DedicatedServerBackupFtpAccessCreateOpts{}).FromResource(d)
+	task := &DedicatedServerTask{}
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP/access",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(opts.IpBlock)
+
+	return resourceDedicatedServerBackupStorageAccessRead(d, meta)
+}
+
+func resourceDedicatedServerBackupStorageAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	access := &DedicatedServerBackupFtpAccess{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP/access/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, access); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("ip_block", access.IpBlock)
+	d.Set("cifs", access.Cifs)
+	d.Set("ftp", access.Ftp)
+	d.Set("nfs", access.Nfs)
+	d.Set("is_applied", access.IsApplied)
+	d.Set("last_update", access.LastUpdate)
+
+	return nil
+}
+
+func resourceDedicatedServerBackupStorageAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&DedicatedServerBackupFtpAccessUpdateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP/access/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return resourceDedicatedServerBackupStorageAccessRead(d, meta)
+}
+
+func resourceDedicatedServerBackupStorageAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/backupFTP/access/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,78 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDedicatedServerBackupStorageAccess_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerBackupStorageAccessConfig, serviceName, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_backup_storage_access.access", "ftp", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_backup_storage_access.access", "nfs", "false"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_backup_storage_access.access", "cifs", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerBackupStorageAccessConfig, serviceName, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_backup_storage_access.access", "nfs", "true"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_backup_storage_access.access",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDedicatedServerBackupStorageAccessImportId("ovh_dedicated_server_backup_storage_access.access"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerBackupStorageAccessImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("backup storage access not found: %s", name)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.ID,
+		), nil
+	}
+}
+
+const testAccDedicatedServerBackupStorageAccessConfig = `
+data "ovh_dedicated_server" "server" {
+  service_name = "%s"
+}
+
+resource "ovh_dedicated_server_backup_storage" "backup" {
+  service_name = data.ovh_dedicated_server.server.service_name
+}
+
+resource "ovh_dedicated_server_backup_storage_access" "access" {
+  service_name = ovh_dedicated_server_backup_storage.backup.service_name
+  ip_block     = "${data.ovh_dedicated_server.server.ip}/32"
+  nfs          = %s
+}
+`
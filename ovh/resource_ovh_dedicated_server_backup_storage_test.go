@@ -0,0 +1,54 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerBackupStorage_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerBackupStorageConfig, serviceName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_backup_storage.backup", "service_name", serviceName),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_backup_storage.backup", "ftp_backup_name"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_backup_storage.backup", "quota.0.value"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerBackupStorageConfig, serviceName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_backup_storage.backup", "password_keepers.0", "second"),
+				),
+			},
+			{
+				ResourceName:            "ovh_dedicated_server_backup_storage.backup",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_keepers"},
+			},
+		},
+	})
+}
+
+const testAccDedicatedServerBackupStorageConfig = `
+resource "ovh_dedicated_server_backup_storage" "backup" {
+  service_name     = "%s"
+  password_keepers = ["%s"]
+}
+`
@@ -1,10 +1,13 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +27,8 @@ func resourceDedicatedServerInstallTask() *schema.Resource {
 			Create: schema.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: resourceDedicatedServerInstallTaskCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"service_name": {
 				Type:        schema.TypeString,
@@ -174,10 +179,188 @@ func resourceDedicatedServerInstallTask() *schema.Resource {
 				Computed:    true,
 				Description: "Task status",
 			},
+			"progress": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Steps of the installation",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the step",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error of the step, if any",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the step",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// minimum number of disks of the hardware RAID modes
+var dedicatedServerHardwareRaidMinDisks = map[string]int{
+	"raid0":  1,
+	"raid1":  2,
+	"raid5":  3,
+	"raid6":  4,
+	"raid10": 4,
+	"raid50": 6,
+	"raid60": 8,
+}
+
+// resourceDedicatedServerInstallTaskCustomizeDiff checks the RAID layout and
+// partition sizes of personal templates against the disks of the server,
+// rather than having the installation fail after several minutes
+func resourceDedicatedServerInstallTaskCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// every argument forces a new install: existing installs are only
+	// checked when they are about to be replaced
+	if d.Id() != "" {
+		changed := false
+		for _, k := range []string{"service_name", "template_name", "partition_scheme_name", "details"} {
+			changed = changed || d.HasChange(k)
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	if !d.NewValueKnown("service_name") ||
+		!d.NewValueKnown("template_name") ||
+		!d.NewValueKnown("partition_scheme_name") ||
+		!d.NewValueKnown("details") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	templateName := d.Get("template_name").(string)
+
+	// OVH templates adapt their layout to the server,
+	// only personal templates are checked
+	templates := []string{}
+	if err := config.OVHClient.Get("/me/installationTemplate", &templates); err != nil {
+		return fmt.Errorf("Error calling GET /me/installationTemplate:\n\t %q", err)
+	}
+	if !dedicatedServerInstallTaskContains(templates, templateName) {
+		log.Printf("[DEBUG] Template %s is not a personal template, its layout is not checked", templateName)
+		return nil
+	}
+
+	schemes, err := getPartitionSchemes(templateName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	// without partition scheme, the one with the highest priority is used
+	schemeName := d.Get("partition_scheme_name").(string)
+	var scheme *PartitionScheme
+	for _, s := range schemes {
+		if (schemeName == "" && (scheme == nil || s.Priority > scheme.Priority)) || s.Name == schemeName {
+			scheme = s
+		}
+	}
+	if scheme == nil {
+		// the partition scheme may be created along with the install
+		log.Printf("[DEBUG] Partition scheme %q of template %s not found, its layout is not checked", schemeName, templateName)
+		return nil
+	}
+
+	partitions, err := getPartitionSchemePartitions(templateName, scheme.Name, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	hardwareRaids, err := getPartitionSchemeHardwareRaids(templateName, scheme.Name, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if len(hardwareRaids) > 0 {
+		profile, err := getDedicatedServerHardwareRaidProfile(serviceName, config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		if err := dedicatedServerInstallTaskCheckHardwareRaids(hardwareRaids, profile); err != nil {
+			return fmt.Errorf("Partition scheme %s of template %s doesn't fit server %s: %s", scheme.Name, templateName, serviceName, err)
+		}
+
+		size, err := getDedicatedServerHardwareRaidSize(serviceName, templateName, scheme.Name, config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		capacity, err := dedicatedServerSpecificationValueMB(size.Capacity)
+		if err != nil {
+			log.Printf("[WARN] Hardware RAID size of server %s can't be checked: %s", serviceName, err)
+			return nil
+		}
+
+		// the hardware RAID is seen as a single disk
+		if err := dedicatedServerInstallTaskCheckPartitions(partitions, capacity, 1); err != nil {
+			return fmt.Errorf("Partition scheme %s of template %s doesn't fit server %s: %s", scheme.Name, templateName, serviceName, err)
+		}
+		return nil
+	}
+
+	hardware, err := getDedicatedServerHardwareSpecifications(serviceName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	diskGroupId := int64(d.Get("details.0.disk_group_id").(int))
+	diskGroup := dedicatedServerInstallTaskDiskGroup(hardware.DiskGroups, diskGroupId)
+	if diskGroup == nil {
+		return fmt.Errorf("Disk group %d not found on server %s", diskGroupId, serviceName)
+	}
+
+	disks := diskGroup.NumberOfDisks
+	if softRaidDevices := int64(d.Get("details.0.soft_raid_devices").(int)); softRaidDevices > 0 {
+		if softRaidDevices > disks {
+			return fmt.Errorf(
+				"soft_raid_devices %d exceeds the %d disks of disk group %d of server %s",
+				softRaidDevices,
+				disks,
+				diskGroup.DiskGroupId,
+				serviceName,
+			)
+		}
+		disks = softRaidDevices
+	}
+	if d.Get("details.0.no_raid").(bool) {
+		disks = 1
+	}
+
+	capacity, err := dedicatedServerSpecificationValueMB(diskGroup.DiskSize)
+	if err != nil {
+		log.Printf("[WARN] Disk size of server %s can't be checked: %s", serviceName, err)
+		return nil
+	}
+
+	if err := dedicatedServerInstallTaskCheckPartitions(partitions, capacity, disks); err != nil {
+		return fmt.Errorf(
+			"Partition scheme %s of template %s doesn't fit disk group %d of server %s: %s",
+			scheme.Name,
+			templateName,
+			diskGroup.DiskGroupId,
+			serviceName,
+			err,
+		)
+	}
+
+	return nil
+}
+
 func resourceDedicatedServerInstallTaskCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
@@ -193,12 +376,21 @@ func resourceDedicatedServerInstallTaskCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
 	}
 
-	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+	progress, err := waitForDedicatedServerInstallTask(serviceName, task, d.Timeout(schema.TimeoutCreate), config.OVHClient)
+	if err != nil {
 		return err
 	}
 
 	d.SetId(fmt.Sprintf("%d", task.Id))
 
+	// the install status is gone once the installation is over,
+	// keep the last known steps
+	steps := make([]map[string]interface{}, len(progress))
+	for i, step := range progress {
+		steps[i] = step.ToMap()
+	}
+	d.Set("progress", steps)
+
 	return resourceDedicatedServerInstallTaskRead(d, meta)
 }
 
@@ -269,3 +461,166 @@ func resourceDedicatedServerInstallTaskDelete(d *schema.ResourceData, meta inter
 	d.SetId("")
 	return nil
 }
+
+func dedicatedServerInstallTaskContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// dedicatedServerInstallTaskDiskGroup returns the disk group of the given id,
+// or the first disk group of the server when id is 0
+func dedicatedServerInstallTaskDiskGroup(diskGroups []DedicatedServerDiskGroup, id int64) *DedicatedServerDiskGroup {
+	var result *DedicatedServerDiskGroup
+	for i := range diskGroups {
+		diskGroup := &diskGroups[i]
+		if id != 0 && diskGroup.DiskGroupId == id {
+			return diskGroup
+		}
+		if id == 0 && (result == nil || diskGroup.DiskGroupId < result.DiskGroupId) {
+			result = diskGroup
+		}
+	}
+	return result
+}
+
+// dedicatedServerInstallTaskCheckHardwareRaids checks the disks of the
+// hardware RAIDs exist on the server, are used once and are enough
+// for the RAID mode
+func dedicatedServerInstallTaskCheckHardwareRaids(raids []*HardwareRaid, profile *DedicatedServerHardwareRaidProfile) error {
+	available := make(map[string]bool)
+	for _, controller := range profile.Controllers {
+		for _, disk := range controller.Disks {
+			for _, name := range disk.Names {
+				available[name] = true
+			}
+		}
+	}
+
+	if len(available) == 0 {
+		return fmt.Errorf("hardware RAID is used but the server has no hardware RAID controller")
+	}
+
+	names := []string{}
+	for name := range available {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	usedBy := make(map[string]string)
+	for _, raid := range raids {
+		disks := dedicatedServerHardwareRaidDiskNames(raid.Disks)
+		for _, disk := range disks {
+			if !available[disk] {
+				return fmt.Errorf(
+					"hardware RAID %s uses disk %s which is not on the server (available disks: %s)",
+					raid.Name,
+					disk,
+					strings.Join(names, ", "),
+				)
+			}
+			if other, ok := usedBy[disk]; ok {
+				return fmt.Errorf("disk %s is used by both hardware RAIDs %s and %s", disk, other, raid.Name)
+			}
+			usedBy[disk] = raid.Name
+		}
+
+		if min, ok := dedicatedServerHardwareRaidMinDisks[raid.Mode]; ok && len(disks) < min {
+			return fmt.Errorf(
+				"hardware RAID %s in %s needs at least %d disks, %d given",
+				raid.Name,
+				raid.Mode,
+				min,
+				len(disks),
+			)
+		}
+	}
+
+	return nil
+}
+
+// dedicatedServerHardwareRaidDiskNames flattens the cX:dY and [cX:dY,cX:dY]
+// syntaxes of hardware RAID disks
+func dedicatedServerHardwareRaidDiskNames(disks []string) []string {
+	names := []string{}
+	for _, disk := range disks {
+		disk = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(disk), "["), "]")
+		for _, name := range strings.Split(disk, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// dedicatedServerInstallTaskCheckPartitions checks the partitions fit on
+// disks of capacity MB each, software RAID spreading them across the disks
+func dedicatedServerInstallTaskCheckPartitions(partitions []*Partition, capacity float64, disks int64) error {
+	used := 0.0
+	for _, partition := range partitions {
+		usage, err := dedicatedServerInstallTaskPartitionUsage(partition, disks)
+		if err != nil {
+			return err
+		}
+		used += usage
+	}
+
+	if used > capacity {
+		return fmt.Errorf("partitions need %.0f MB per disk, disks only have %.0f MB", used, capacity)
+	}
+
+	return nil
+}
+
+// dedicatedServerInstallTaskPartitionUsage returns the space in MB the
+// partition takes on each disk
+func dedicatedServerInstallTaskPartitionUsage(partition *Partition, disks int64) (float64, error) {
+	size, err := dedicatedServerSpecificationValueMB(&DedicatedServerSpecificationValue{
+		Unit:  partition.Size.Unit,
+		Value: float64(partition.Size.Value),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("partition %s: %s", partition.Mountpoint, err)
+	}
+
+	raid := ""
+	if partition.Raid != nil {
+		raid = strings.TrimPrefix(*partition.Raid, "raid")
+	}
+
+	switch {
+	case raid == "0" && disks >= 2:
+		return size / float64(disks), nil
+	case raid == "5" && disks >= 3:
+		return size / float64(disks-1), nil
+	case raid == "6" && disks >= 4:
+		return size / float64(disks-2), nil
+	case raid == "10" && disks >= 4:
+		return size * 2 / float64(disks), nil
+	}
+
+	// raid1, no raid, or not enough disks for the raid:
+	// the whole partition lies on a disk
+	return size, nil
+}
+
+func dedicatedServerSpecificationValueMB(v *DedicatedServerSpecificationValue) (float64, error) {
+	if v == nil {
+		return 0, fmt.Errorf("size is missing")
+	}
+
+	switch v.Unit {
+	case "MB":
+		return v.Value, nil
+	case "GB":
+		return v.Value * 1024, nil
+	case "TB":
+		return v.Value * 1024 * 1024, nil
+	}
+
+	return 0, fmt.Errorf("unknown size unit %s", v.Unit)
+}
@@ -30,6 +30,10 @@ func TestAccDedicatedServerInstall_basic(t *testing.T) {
 						"ovh_dedicated_server_install_task.server_install", "function", "reinstallServer"),
 					resource.TestCheckResourceAttr(
 						"ovh_dedicated_server_install_task.server_install", "status", "done"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_install_task.server_install", "progress.0.comment"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_install_task.server_install", "progress.0.status", "done"),
 				),
 			},
 		},
@@ -168,3 +172,65 @@ resource ovh_dedicated_server_install_task "server_install" {
   bootid_on_destroy = data.ovh_dedicated_server_boots.rescue.result[0]
 }
 `
+
+func TestDedicatedServerInstallTaskCheckPartitions(t *testing.T) {
+	raid1 := "raid1"
+	raid0 := "raid0"
+	raid5 := "5"
+
+	partitions := []*Partition{
+		{Mountpoint: "/boot", Raid: &raid1, Size: UnitAndValue{Unit: "MB", Value: 512}},
+		{Mountpoint: "/", Raid: &raid5, Size: UnitAndValue{Unit: "GB", Value: 20}},
+		{Mountpoint: "/data", Raid: &raid0, Size: UnitAndValue{Unit: "GB", Value: 30}},
+	}
+
+	// 512 + 20480/2 + 30720/3 = 20992 MB per disk
+	if err := dedicatedServerInstallTaskCheckPartitions(partitions, 20992, 3); err != nil {
+		t.Fatalf("expected partitions to fit on 3 disks, got %s", err)
+	}
+
+	if err := dedicatedServerInstallTaskCheckPartitions(partitions, 20991, 3); err == nil {
+		t.Fatalf("expected partitions not to fit on 3 disks")
+	}
+
+	// a single disk gets everything
+	if err := dedicatedServerInstallTaskCheckPartitions(partitions, 51711, 1); err == nil {
+		t.Fatalf("expected partitions not to fit on 1 disk")
+	}
+}
+
+func TestDedicatedServerInstallTaskCheckHardwareRaids(t *testing.T) {
+	profile := &DedicatedServerHardwareRaidProfile{
+		Controllers: []DedicatedServerHardwareRaidController{
+			{
+				Disks: []DedicatedServerHardwareRaidDisk{
+					{Names: []string{"c0:d0", "c0:d1", "c0:d2"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		raids []*HardwareRaid
+		valid bool
+	}{
+		{[]*HardwareRaid{{Name: "r", Mode: "raid5", Disks: []string{"[c0:d0,c0:d1,c0:d2]"}}}, true},
+		{[]*HardwareRaid{{Name: "r", Mode: "raid1", Disks: []string{"c0:d0", "c0:d1"}}}, true},
+		{[]*HardwareRaid{{Name: "r", Mode: "raid1", Disks: []string{"c0:d0", "c0:d3"}}}, false},
+		{[]*HardwareRaid{{Name: "r", Mode: "raid6", Disks: []string{"[c0:d0,c0:d1,c0:d2]"}}}, false},
+		{[]*HardwareRaid{
+			{Name: "a", Mode: "raid0", Disks: []string{"c0:d0"}},
+			{Name: "b", Mode: "raid1", Disks: []string{"c0:d0", "c0:d1"}},
+		}, false},
+	}
+
+	for i, test := range tests {
+		err := dedicatedServerInstallTaskCheckHardwareRaids(test.raids, profile)
+		if test.valid && err != nil {
+			t.Errorf("test %d: expected valid layout, got %s", i, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("test %d: expected invalid layout", i)
+		}
+	}
+}
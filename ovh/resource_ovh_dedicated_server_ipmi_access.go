@@ -0,0 +1,158 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerIpmiAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerIpmiAccessCreate,
+		Read:   resourceDedicatedServerIpmiAccessRead,
+		Delete: resourceDedicatedServerIpmiAccessDelete,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IPMI console access type (kvmipHtml5URL, serialOverLanSshKey, serialOverLanURL)",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{
+						"kvmipHtml5URL",
+						"serialOverLanSshKey",
+						"serialOverLanURL",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     1,
+				Description: "Session access time to live in minutes",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(int) {
+					case 1, 3, 5, 10, 15:
+					default:
+						errors = append(errors, fmt.Errorf("Value %d is not among valid values (1, 3, 5, 10, 15)", v.(int)))
+					}
+					return
+				},
+			},
+			"ip_to_allow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "IP allowed to access the console. Mandatory for the URL access types",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ssh_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Public SSH key for the serialOverLanSshKey access type",
+			},
+
+			//Computed
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the access",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The console access: an URL or a SSH command depending on the access type",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerIpmiAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&DedicatedServerIpmiAccessOpts{}).FromResource(d)
+	if opts.Type == "serialOverLanSshKey" && opts.SshKey == nil {
+		return fmt.Errorf("ssh_key is mandatory for access type %s", opts.Type)
+	}
+	if opts.Type != "serialOverLanSshKey" && opts.IpToAllow == nil {
+		return fmt.Errorf("ip_to_allow is mandatory for access type %s", opts.Type)
+	}
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/ipmi/access",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", task.Id))
+
+	return resourceDedicatedServerIpmiAccessRead(d, meta)
+}
+
+func resourceDedicatedServerIpmiAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	access := &DedicatedServerIpmiAccess{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/features/ipmi/access?type=%s",
+		url.PathEscape(serviceName),
+		url.QueryEscape(d.Get("type").(string)),
+	)
+
+	if err := config.OVHClient.Get(endpoint, access); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	// an expired access is of no use, forget it so the next apply
+	// requests a new one
+	if expiration, err := time.Parse(time.RFC3339, access.Expiration); err == nil && expiration.Before(time.Now()) {
+		log.Printf("[WARN] IPMI access %s on dedicated server %s expired on %s", d.Get("type").(string), serviceName, access.Expiration)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("expiration", access.Expiration)
+	d.Set("value", access.Value)
+
+	return nil
+}
+
+func resourceDedicatedServerIpmiAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	// the access can't be revoked through the API, it expires with its ttl
+	d.SetId("")
+	return nil
+}
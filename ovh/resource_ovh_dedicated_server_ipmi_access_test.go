@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerIpmiAccess_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServerIpmi(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServerIpmiAccessConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_ipmi_access.console", "type", "kvmipHtml5URL"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_ipmi_access.console", "value"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_ipmi_access.console", "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerIpmiAccessConfig() string {
+	dedicated_server := os.Getenv("OVH_DEDICATED_SERVER")
+	ip := os.Getenv("OVH_IPMI_IP_TO_ALLOW")
+	return fmt.Sprintf(
+		testAccDedicatedServerIpmiAccessConfig_Basic,
+		dedicated_server,
+		ip,
+	)
+}
+
+const testAccDedicatedServerIpmiAccessConfig_Basic = `
+resource ovh_dedicated_server_ipmi_access "console" {
+  service_name = "%s"
+  type         = "kvmipHtml5URL"
+  ttl          = 5
+  ip_to_allow  = "%s"
+}
+`
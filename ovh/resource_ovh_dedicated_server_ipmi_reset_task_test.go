@@ -0,0 +1,46 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerIpmiReset_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServerIpmiResetConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_ipmi_reset_task.bmc_reset", "status", "done"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerIpmiResetConfig() string {
+	dedicated_server := os.Getenv("OVH_DEDICATED_SERVER")
+	return fmt.Sprintf(
+		testAccDedicatedServerIpmiResetConfig_Basic,
+		dedicated_server,
+	)
+}
+
+const testAccDedicatedServerIpmiResetConfig_Basic = `
+resource ovh_dedicated_server_ipmi_reset_task "bmc_reset" {
+  service_name = "%s"
+
+  keepers = [
+     "tf-test",
+  ]
+}
+`
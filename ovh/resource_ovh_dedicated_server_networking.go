@@ -0,0 +1,341 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func resourceDedicatedServerNetworking() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerNetworkingCreate,
+		Read:   resourceDedicatedServerNetworkingRead,
+		Delete: resourceDedicatedServerNetworkingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerNetworkingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the aggregated VirtualNetworkInterface",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the aggregation (public, vrack)",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"public", "vrack"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"nics": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    2,
+				Description: "NetworkInterfaceControllers (MAC addresses) to aggregate",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					// MAC addresses are case insensitive
+					StateFunc: func(v interface{}) string {
+						return strings.ToLower(v.(string))
+					},
+				},
+				Set: func(v interface{}) int {
+					return hashcode.String(strings.ToLower(v.(string)))
+				},
+			},
+
+			// Computed
+			"mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mode of the aggregated VirtualNetworkInterface (public_aggregation, vrack_aggregation)",
+			},
+			"vnis": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "VirtualNetworkInterfaces layout of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "VirtualNetworkInterface activation state",
+						},
+						"mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VirtualNetworkInterface mode (public,vrack,vrack_aggregation)",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User defined VirtualNetworkInterface name",
+						},
+						"uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VirtualNetworkInterface unique id",
+						},
+						"server_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "server name",
+						},
+						"vrack": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "vRack name",
+						},
+						"nics": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "NetworkInterfaceControllers bound to this VirtualNetworkInterface",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerNetworkingImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/VNI_UUID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerNetworkingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	mode := d.Get("type").(string)
+
+	nics := []string{}
+	for _, nic := range d.Get("nics").(*schema.Set).List() {
+		nics = append(nics, nic.(string))
+	}
+
+	vnis, err := getDedicatedServerVNIs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	uuids, err := dedicatedServerNetworkingSelectVNIs(vnis, mode, nics)
+	if err != nil {
+		return err
+	}
+
+	opts := &DedicatedServerOlaAggregationOpts{
+		Name:                     d.Get("name").(string),
+		VirtualNetworkInterfaces: uuids,
+	}
+	task := &DedicatedServerTask{}
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/ola/aggregation",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	// the aggregation is a new VNI holding every aggregated NIC
+	vnis, err = getDedicatedServerVNIs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	aggregation := dedicatedServerNetworkingFindAggregation(vnis, mode, nics)
+	if aggregation == nil {
+		return fmt.Errorf("No %s_aggregation VNI found for NICs %v on dedicated server %s", mode, nics, serviceName)
+	}
+
+	d.SetId(aggregation.Uuid)
+
+	return resourceDedicatedServerNetworkingRead(d, meta)
+}
+
+func resourceDedicatedServerNetworkingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	vni := &DedicatedServerVNI{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualNetworkInterface/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, vni); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if !strings.HasSuffix(vni.Mode, "_aggregation") {
+		log.Printf("[WARN] VNI %s of dedicated server %s is no longer aggregated", d.Id(), serviceName)
+		d.SetId("")
+		return nil
+	}
+
+	vnis, err := getDedicatedServerVNIs(d, meta)
+	if err != nil {
+		return err
+	}
+
+	mapvnis := make([]map[string]interface{}, len(vnis))
+	for i, v := range vnis {
+		mapvnis[i] = v.ToMap()
+	}
+
+	d.Set("name", vni.Name)
+	d.Set("type", strings.TrimSuffix(vni.Mode, "_aggregation"))
+	d.Set("mode", vni.Mode)
+	nics := make([]string, len(vni.NICs))
+	for i, nic := range vni.NICs {
+		nics[i] = strings.ToLower(nic)
+	}
+
+	d.Set("nics", nics)
+	d.Set("vnis", mapvnis)
+
+	return nil
+}
+
+func resourceDedicatedServerNetworkingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := &DedicatedServerOlaResetOpts{
+		VirtualNetworkInterface: d.Id(),
+	}
+	tasks := []DedicatedServerTask{}
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/ola/reset",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, &tasks); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	for i := range tasks {
+		if err := waitForDedicatedServerTask(serviceName, &tasks[i], config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dedicatedServerNetworkingSelectVNIs returns the uuids of the VNIs of the
+// given mode bound to nics. Every nic must be covered and no VNI may hold
+// a nic outside of nics.
+func dedicatedServerNetworkingSelectVNIs(vnis []*DedicatedServerVNI, mode string, nics []string) ([]string, error) {
+	wanted := make(map[string]bool)
+	for _, nic := range nics {
+		wanted[strings.ToLower(nic)] = false
+	}
+
+	uuids := []string{}
+	for _, vni := range vnis {
+		if vni.Mode != mode {
+			continue
+		}
+
+		bound := false
+		for _, nic := range vni.NICs {
+			if _, ok := wanted[strings.ToLower(nic)]; ok {
+				bound = true
+			}
+		}
+		if !bound {
+			continue
+		}
+
+		for _, nic := range vni.NICs {
+			if _, ok := wanted[strings.ToLower(nic)]; !ok {
+				return nil, fmt.Errorf("VNI %s also holds NIC %s which is not part of the aggregation", vni.Uuid, nic)
+			}
+			wanted[strings.ToLower(nic)] = true
+		}
+		uuids = append(uuids, vni.Uuid)
+	}
+
+	missing := []string{}
+	for nic, found := range wanted {
+		if !found {
+			missing = append(missing, nic)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("No %s VNI found for NICs %s", mode, strings.Join(missing, ", "))
+	}
+
+	sort.Strings(uuids)
+	return uuids, nil
+}
+
+// dedicatedServerNetworkingFindAggregation returns the aggregated VNI
+// of the given type holding nics
+func dedicatedServerNetworkingFindAggregation(vnis []*DedicatedServerVNI, mode string, nics []string) *DedicatedServerVNI {
+	for _, vni := range vnis {
+		if vni.Mode != mode+"_aggregation" || len(vni.NICs) != len(nics) {
+			continue
+		}
+
+		held := make(map[string]bool)
+		for _, nic := range vni.NICs {
+			held[strings.ToLower(nic)] = true
+		}
+
+		match := true
+		for _, nic := range nics {
+			if !held[strings.ToLower(nic)] {
+				match = false
+			}
+		}
+		if match {
+			return vni
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerNetworking_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDedicatedServerNetworking(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServerNetworkingConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_networking.ola", "mode", "vrack_aggregation"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_networking.ola", "nics.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_networking.ola", "vnis.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerNetworkingConfig() string {
+	dedicated_server := os.Getenv("OVH_DEDICATED_SERVER_OLA")
+	return fmt.Sprintf(
+		testAccDedicatedServerNetworkingConfig_Basic,
+		dedicated_server,
+	)
+}
+
+const testAccDedicatedServerNetworkingConfig_Basic = `
+data ovh_dedicated_server "server" {
+  service_name = "%s"
+}
+
+resource ovh_dedicated_server_networking "ola" {
+  service_name = data.ovh_dedicated_server.server.service_name
+  name         = "tf-test-ola"
+  type         = "vrack"
+  nics         = flatten([for vni in data.ovh_dedicated_server.server.vnis : vni.nics if vni.mode == "vrack"])
+}
+`
+
+func TestDedicatedServerNetworkingSelectVNIs(t *testing.T) {
+	vnis := []*DedicatedServerVNI{
+		{Uuid: "pub-1", Mode: "public", NICs: []string{"00:00:00:00:00:01"}},
+		{Uuid: "pub-2", Mode: "public", NICs: []string{"00:00:00:00:00:02"}},
+		{Uuid: "vrack-2", Mode: "vrack", NICs: []string{"00:00:00:00:00:04"}},
+		{Uuid: "vrack-1", Mode: "vrack", NICs: []string{"00:00:00:00:00:03"}},
+	}
+
+	uuids, err := dedicatedServerNetworkingSelectVNIs(vnis, "vrack", []string{"00:00:00:00:00:03", "00:00:00:00:00:04"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"vrack-1", "vrack-2"}; !reflect.DeepEqual(uuids, expected) {
+		t.Errorf("expected %v, got %v", expected, uuids)
+	}
+
+	if _, err := dedicatedServerNetworkingSelectVNIs(vnis, "vrack", []string{"00:00:00:00:00:01", "00:00:00:00:00:03"}); err == nil {
+		t.Errorf("expected an error for a NIC outside of the vrack VNIs")
+	}
+
+	aggregated := append(vnis, &DedicatedServerVNI{
+		Uuid: "ola",
+		Mode: "public_aggregation",
+		NICs: []string{"00:00:00:00:00:01", "00:00:00:00:00:05"},
+	})
+	if _, err := dedicatedServerNetworkingSelectVNIs(aggregated, "public_aggregation", []string{"00:00:00:00:00:01", "00:00:00:00:00:02"}); err == nil {
+		t.Errorf("expected an error for a VNI holding a NIC outside of the aggregation")
+	}
+
+	vni := dedicatedServerNetworkingFindAggregation(aggregated, "public", []string{"00:00:00:00:00:05", "00:00:00:00:00:01"})
+	if vni == nil || vni.Uuid != "ola" {
+		t.Errorf("expected to find aggregation ola, got %v", vni)
+	}
+}
@@ -0,0 +1,267 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerRescue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerRescueCreate,
+		Read:   resourceDedicatedServerRescueRead,
+		Delete: resourceDedicatedServerRescueDelete,
+
+		CustomizeDiff: resourceDedicatedServerRescueCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"boot_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The rescue boot id. Defaults to the rescue netboot with the given kernel",
+				ConflictsWith: []string{"kernel"},
+			},
+			"kernel": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Kernel of the rescue netboot, used when boot_id is not set",
+				ConflictsWith: []string{"boot_id"},
+			},
+			"rescue_mail": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Email the rescue credentials are sent to, instead of the account email",
+			},
+			"rescue_ssh_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Public SSH key allowed to log into the rescue",
+			},
+
+			//Computed
+			"current_boot_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The boot id the server is currently set to",
+			},
+			"original_boot_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The boot id restored on destroy",
+			},
+			"rescue_task_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Id of the reboot task into rescue",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerRescueCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	ds, err := getDedicatedServer(serviceName, config)
+	if err != nil {
+		return err
+	}
+
+	bootId := int64(d.Get("boot_id").(int))
+	if bootId == 0 {
+		bootId, err = dedicatedServerRescueBootId(serviceName, d.Get("kernel").(string), config)
+		if err != nil {
+			return err
+		}
+	}
+
+	if int64(ds.BootId) == bootId {
+		return fmt.Errorf("Dedicated server %s is already booting on rescue netboot %d", serviceName, bootId)
+	}
+
+	log.Printf("[INFO] Switching dedicated server %s from boot %d to rescue boot %d", serviceName, ds.BootId, bootId)
+
+	opts := &DedicatedServerRescueOpts{
+		BootId:       bootId,
+		RescueMail:   helpers.GetNilStringPointerFromData(d, "rescue_mail"),
+		RescueSshKey: helpers.GetNilStringPointerFromData(d, "rescue_ssh_key"),
+	}
+	if err := updateDedicatedServerBoot(serviceName, opts, config); err != nil {
+		return err
+	}
+
+	d.SetId(serviceName)
+	d.Set("boot_id", bootId)
+	d.Set("original_boot_id", ds.BootId)
+
+	task, err := rebootDedicatedServer(serviceName, config)
+	if err != nil {
+		return err
+	}
+	d.Set("rescue_task_id", task.Id)
+
+	return resourceDedicatedServerRescueRead(d, meta)
+}
+
+func resourceDedicatedServerRescueRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+
+	ds := &DedicatedServer{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Get(endpoint, ds); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	// the server may have been switched to another boot outside terraform,
+	// the resource is kept so that original_boot_id isn't lost and
+	// the drift is reported by CustomizeDiff
+	if int64(ds.BootId) != int64(d.Get("boot_id").(int)) {
+		log.Printf("[WARN] Dedicated server %s is no longer booting on rescue netboot %d", serviceName, d.Get("boot_id").(int))
+	}
+
+	d.Set("service_name", serviceName)
+	d.Set("current_boot_id", ds.BootId)
+
+	return nil
+}
+
+// resourceDedicatedServerRescueCustomizeDiff plans a new rescue session
+// when the boot of the server has been changed outside terraform
+func resourceDedicatedServerRescueCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	bootId, _ := d.GetChange("boot_id")
+	currentBootId, _ := d.GetChange("current_boot_id")
+	if bootId.(int) == currentBootId.(int) {
+		return nil
+	}
+
+	if err := d.SetNewComputed("current_boot_id"); err != nil {
+		return err
+	}
+	return d.ForceNew("current_boot_id")
+}
+
+func resourceDedicatedServerRescueDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Id()
+	originalBootId := int64(d.Get("original_boot_id").(int))
+
+	log.Printf("[INFO] Restoring dedicated server %s boot %d", serviceName, originalBootId)
+
+	opts := &DedicatedServerRescueOpts{BootId: originalBootId}
+	if err := updateDedicatedServerBoot(serviceName, opts, config); err != nil {
+		return err
+	}
+
+	if _, err := rebootDedicatedServer(serviceName, config); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dedicatedServerRescueBootId returns the first rescue netboot of the server,
+// restricted to the given kernel if not empty
+func dedicatedServerRescueBootId(serviceName, kernel string, config *Config) (int64, error) {
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/boot?bootType=rescue",
+		url.PathEscape(serviceName),
+	)
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return 0, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, id := range ids {
+		if kernel == "" {
+			return id, nil
+		}
+
+		boot := &DedicatedServerBoot{}
+		bootEndpoint := fmt.Sprintf(
+			"/dedicated/server/%s/boot/%d",
+			url.PathEscape(serviceName),
+			id,
+		)
+		if err := config.OVHClient.Get(bootEndpoint, boot); err != nil {
+			return 0, fmt.Errorf("Error calling GET %s:\n\t %q", bootEndpoint, err)
+		}
+
+		if boot.Kernel == kernel {
+			return id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("No rescue netboot found for dedicated server %s with kernel %q", serviceName, kernel)
+}
+
+func getDedicatedServer(serviceName string, config *Config) (*DedicatedServer, error) {
+	ds := &DedicatedServer{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Get(endpoint, ds); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return ds, nil
+}
+
+func updateDedicatedServerBoot(serviceName string, opts *DedicatedServerRescueOpts, config *Config) error {
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return nil
+}
+
+func rebootDedicatedServer(serviceName string, config *Config) (*DedicatedServerTask, error) {
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/reboot",
+		url.PathEscape(serviceName),
+	)
+
+	task := &DedicatedServerTask{}
+	if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+		return nil, fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Dedicated server %s rebooted by task %d", serviceName, task.Id)
+	return task, nil
+}
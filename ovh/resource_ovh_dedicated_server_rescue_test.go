@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDedicatedServerRescue_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedServerRescueConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ovh_dedicated_server_rescue.rescue", "boot_id",
+						"data.ovh_dedicated_server_boots.rescue", "result.0",
+					),
+					resource.TestCheckResourceAttrPair(
+						"ovh_dedicated_server_rescue.rescue", "current_boot_id",
+						"ovh_dedicated_server_rescue.rescue", "boot_id"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_rescue.rescue", "original_boot_id"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_rescue.rescue", "rescue_task_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerRescueConfig() string {
+	dedicated_server := os.Getenv("OVH_DEDICATED_SERVER")
+	return fmt.Sprintf(
+		testAccDedicatedServerRescueConfig_Basic,
+		dedicated_server,
+	)
+}
+
+const testAccDedicatedServerRescueConfig_Basic = `
+data ovh_dedicated_server_boots "rescue" {
+  service_name = "%s"
+  boot_type    = "rescue"
+  kernel       = "rescue64-pro"
+}
+
+resource ovh_dedicated_server_rescue "rescue" {
+  service_name = data.ovh_dedicated_server_boots.rescue.service_name
+  boot_id      = data.ovh_dedicated_server_boots.rescue.result[0]
+}
+`
@@ -0,0 +1,207 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerSecondaryDnsDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerSecondaryDnsDomainCreate,
+		Read:   resourceDedicatedServerSecondaryDnsDomainRead,
+		Update: resourceDedicatedServerSecondaryDnsDomainUpdate,
+		Delete: resourceDedicatedServerSecondaryDnsDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerSecondaryDnsDomainImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain to host as secondary DNS",
+			},
+			"ip_master": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The IP of the primary DNS. Defaults to the dedicated server IP",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the secondary DNS domain",
+			},
+			"dns_server": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hostname of the secondary name server",
+			},
+			"dns_server_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP of the secondary name server",
+			},
+			"sub_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subdomain of the TXT record holding the ownership token",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ownership validation token",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerSecondaryDnsDomainImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/DOMAIN formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+	d.Set("domain", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerSecondaryDnsDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&DedicatedServerSecondaryDnsDomainCreateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/secondaryDnsDomains",
+		url.PathEscape(serviceName),
+	)
+
+	// the ownership of the domain is checked against the TXT record
+	// published from the secondaryDnsNameDomainToken
+	token, err := getDedicatedServerSecondaryDnsNameDomainToken(serviceName, opts.Domain, config.OVHClient)
+	if err != nil {
+		return err
+	}
+	d.Set("sub_domain", token.SubDomain)
+	d.Set("token", token.Token)
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf(
+			"Error calling POST %s with opts %v, check the TXT record %s.%s is set to %s:\n\t %q",
+			endpoint,
+			opts,
+			token.SubDomain,
+			opts.Domain,
+			token.Token,
+			err,
+		)
+	}
+
+	d.SetId(opts.Domain)
+
+	return resourceDedicatedServerSecondaryDnsDomainRead(d, meta)
+}
+
+func resourceDedicatedServerSecondaryDnsDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	secondary := &DedicatedServerSecondaryDnsDomain{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/secondaryDnsDomains/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, secondary); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	dnsServer := &DedicatedServerSecondaryDnsServer{}
+	dnsServerEndpoint := fmt.Sprintf("%s/dnsServer", endpoint)
+
+	if err := config.OVHClient.Get(dnsServerEndpoint, dnsServer); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", dnsServerEndpoint, err)
+	}
+
+	// token is only missing after an import
+	if d.Get("token").(string) == "" {
+		token, err := getDedicatedServerSecondaryDnsNameDomainToken(serviceName, secondary.Domain, config.OVHClient)
+		if err != nil {
+			return err
+		}
+		d.Set("sub_domain", token.SubDomain)
+		d.Set("token", token.Token)
+	}
+
+	d.Set("domain", secondary.Domain)
+	d.Set("ip_master", secondary.IpMaster)
+	d.Set("creation_date", secondary.CreationDate)
+	d.Set("dns_server", dnsServer.Hostname)
+	d.Set("dns_server_ip", dnsServer.Ip)
+
+	return nil
+}
+
+func resourceDedicatedServerSecondaryDnsDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := &DedicatedServerSecondaryDnsDomainUpdateOpts{
+		IpMaster: d.Get("ip_master").(string),
+	}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/secondaryDnsDomains/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return resourceDedicatedServerSecondaryDnsDomainRead(d, meta)
+}
+
+func resourceDedicatedServerSecondaryDnsDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/secondaryDnsDomains/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDedicatedServerSecondaryDnsDomain_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDedicatedServer(t)
+			testAccPreCheckDomain(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccDedicatedServerSecondaryDnsDomainConfig,
+					os.Getenv("OVH_DEDICATED_SERVER"),
+					zone,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_secondary_dns_domain.secondary", "domain", zone),
+					resource.TestCheckResourceAttrPair(
+						"ovh_dedicated_server_secondary_dns_domain.secondary", "ip_master",
+						"data.ovh_dedicated_server.server", "ip",
+					),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_secondary_dns_domain.secondary", "dns_server"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_secondary_dns_domain.secondary", "dns_server_ip"),
+					resource.TestCheckResourceAttrPair(
+						"ovh_dedicated_server_secondary_dns_domain.secondary", "token",
+						"data.ovh_dedicated_server_secondary_dns_domain_token.token", "token",
+					),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_secondary_dns_domain.secondary",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDedicatedServerSecondaryDnsDomainImportId("ovh_dedicated_server_secondary_dns_domain.secondary"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerSecondaryDnsDomainImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("secondary dns domain not found: %s", name)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.ID,
+		), nil
+	}
+}
+
+const testAccDedicatedServerSecondaryDnsDomainConfig = `
+data "ovh_dedicated_server" "server" {
+  service_name = "%s"
+}
+
+data "ovh_dedicated_server_secondary_dns_domain_token" "token" {
+  service_name = data.ovh_dedicated_server.server.service_name
+  domain       = "%s"
+}
+
+resource "ovh_domain_zone_record" "ownercheck" {
+  zone      = data.ovh_dedicated_server_secondary_dns_domain_token.token.domain
+  subdomain = data.ovh_dedicated_server_secondary_dns_domain_token.token.sub_domain
+  fieldtype = "TXT"
+  ttl       = 60
+  target    = data.ovh_dedicated_server_secondary_dns_domain_token.token.token
+}
+
+resource "ovh_dedicated_server_secondary_dns_domain" "secondary" {
+  service_name = data.ovh_dedicated_server.server.service_name
+  domain       = data.ovh_dedicated_server_secondary_dns_domain_token.token.domain
+
+  depends_on = [ovh_domain_zone_record.ownercheck]
+}
+`
@@ -0,0 +1,211 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerServiceMonitoring() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerServiceMonitoringCreate,
+		Read:   resourceDedicatedServerServiceMonitoringRead,
+		Update: resourceDedicatedServerServiceMonitoringUpdate,
+		Delete: resourceDedicatedServerServiceMonitoringDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerServiceMonitoringImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP to monitor",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The port to monitor",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The protocol to monitor (DNS, FTP, HTTP, IMAP, POP, SMTP, SSH, openTCP)",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{
+						"DNS",
+						"FTP",
+						"HTTP",
+						"IMAP",
+						"POP",
+						"SMTP",
+						"SSH",
+						"openTCP",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"interval": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The monitoring interval in seconds (300, 900, 1800, 3600, 21600)",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{
+						"300",
+						"900",
+						"1800",
+						"3600",
+						"21600",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"challenge_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The expected return of the monitored service",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL to test when protocol is HTTP",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the monitoring is enabled",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerServiceMonitoringImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/MONITORING_ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerServiceMonitoringCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&DedicatedServerServiceMonitoringCreateOpts{}).FromResource(d)
+	monitoring := &DedicatedServerServiceMonitoring{}
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/serviceMonitoring",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, monitoring); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(strconv.FormatInt(monitoring.MonitoringId, 10))
+
+	// monitorings are enabled on creation
+	if !d.Get("enabled").(bool) {
+		return resourceDedicatedServerServiceMonitoringUpdate(d, meta)
+	}
+
+	return resourceDedicatedServerServiceMonitoringRead(d, meta)
+}
+
+func resourceDedicatedServerServiceMonitoringRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	monitoring := &DedicatedServerServiceMonitoring{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/serviceMonitoring/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, monitoring); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("enabled", monitoring.Enabled)
+	d.Set("interval", monitoring.Interval)
+	d.Set("ip", monitoring.Ip)
+	d.Set("port", monitoring.Port)
+	d.Set("protocol", monitoring.Protocol)
+
+	if monitoring.ChallengeText != nil {
+		d.Set("challenge_text", *monitoring.ChallengeText)
+	}
+	if monitoring.Url != nil {
+		d.Set("url", *monitoring.Url)
+	}
+
+	return nil
+}
+
+func resourceDedicatedServerServiceMonitoringUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&DedicatedServerServiceMonitoringUpdateOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/serviceMonitoring/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return resourceDedicatedServerServiceMonitoringRead(d, meta)
+}
+
+func resourceDedicatedServerServiceMonitoringDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/serviceMonitoring/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
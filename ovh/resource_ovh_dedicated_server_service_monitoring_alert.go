@@ -0,0 +1,285 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerServiceMonitoringAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerServiceMonitoringAlertCreate,
+		Read:   resourceDedicatedServerServiceMonitoringAlertRead,
+		Update: resourceDedicatedServerServiceMonitoringAlertUpdate,
+		Delete: resourceDedicatedServerServiceMonitoringAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerServiceMonitoringAlertImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"monitoring_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the service monitoring",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The alert type (email, sms)",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"email", "sms"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The alert language",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{
+						"cz",
+						"de",
+						"en",
+						"es",
+						"fi",
+						"fr",
+						"it",
+						"lt",
+						"nl",
+						"pl",
+						"pt",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// email
+			"email": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The email alerts are sent to. Mandatory for email alerts",
+				ConflictsWith: []string{"phone_number_to", "sms_account", "from_hour", "to_hour"},
+			},
+
+			// sms
+			"phone_number_to": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The phone number alerts are sent to. Mandatory for sms alerts",
+				ConflictsWith: []string{"email"},
+			},
+			"sms_account": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The SMS account used to send the alerts. Mandatory for sms alerts",
+				ConflictsWith: []string{"email"},
+			},
+			"from_hour": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Description:   "Hour from which sms alerts are sent",
+				ConflictsWith: []string{"email"},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 0 || v.(int) > 23 {
+						errors = append(errors, fmt.Errorf("Value %d is not a valid hour (0-23)", v.(int)))
+					}
+					return
+				},
+			},
+			"to_hour": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Description:   "Hour until which sms alerts are sent",
+				ConflictsWith: []string{"email"},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 0 || v.(int) > 23 {
+						errors = append(errors, fmt.Errorf("Value %d is not a valid hour (0-23)", v.(int)))
+					}
+					return
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether sms alerts are enabled. Email alerts are always enabled",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerServiceMonitoringAlertImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 4)
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/MONITORING_ID/TYPE/ALERT_ID formatted")
+	}
+	d.SetId(splitId[3])
+	d.Set("service_name", splitId[0])
+	d.Set("monitoring_id", splitId[1])
+	d.Set("type", splitId[2])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func dedicatedServerServiceMonitoringAlertEndpoint(d *schema.ResourceData) string {
+	return fmt.Sprintf(
+		"/dedicated/server/%s/serviceMonitoring/%s/alert/%s",
+		url.PathEscape(d.Get("service_name").(string)),
+		url.PathEscape(d.Get("monitoring_id").(string)),
+		url.PathEscape(d.Get("type").(string)),
+	)
+}
+
+func resourceDedicatedServerServiceMonitoringAlertCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := dedicatedServerServiceMonitoringAlertEndpoint(d)
+
+	var alertId int64
+	switch d.Get("type").(string) {
+	case "email":
+		if d.Get("email").(string) == "" {
+			return fmt.Errorf("email is mandatory for email alerts")
+		}
+		if !d.Get("enabled").(bool) {
+			return fmt.Errorf("email alerts can't be disabled, enabled only applies to sms alerts")
+		}
+
+		opts := (&DedicatedServerServiceMonitoringAlertEmailOpts{}).FromResource(d)
+		alert := &DedicatedServerServiceMonitoringAlertEmail{}
+		if err := config.OVHClient.Post(endpoint, opts, alert); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+		alertId = alert.AlertId
+
+	case "sms":
+		if d.Get("phone_number_to").(string) == "" || d.Get("sms_account").(string) == "" {
+			return fmt.Errorf("phone_number_to and sms_account are mandatory for sms alerts")
+		}
+
+		opts := (&DedicatedServerServiceMonitoringAlertSmsCreateOpts{}).FromResource(d)
+		alert := &DedicatedServerServiceMonitoringAlertSms{}
+		if err := config.OVHClient.Post(endpoint, opts, alert); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+		alertId = alert.AlertId
+	}
+
+	d.SetId(strconv.FormatInt(alertId, 10))
+
+	// sms alerts are enabled on creation
+	if d.Get("type").(string) == "sms" && !d.Get("enabled").(bool) {
+		return resourceDedicatedServerServiceMonitoringAlertUpdate(d, meta)
+	}
+
+	return resourceDedicatedServerServiceMonitoringAlertRead(d, meta)
+}
+
+func resourceDedicatedServerServiceMonitoringAlertRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := fmt.Sprintf(
+		"%s/%s",
+		dedicatedServerServiceMonitoringAlertEndpoint(d),
+		url.PathEscape(d.Id()),
+	)
+
+	switch d.Get("type").(string) {
+	case "email":
+		alert := &DedicatedServerServiceMonitoringAlertEmail{}
+		if err := config.OVHClient.Get(endpoint, alert); err != nil {
+			return helpers.CheckDeleted(d, err, endpoint)
+		}
+
+		// email alerts are always enabled
+		d.Set("enabled", true)
+		d.Set("email", alert.Email)
+		d.Set("language", alert.Language)
+
+	case "sms":
+		alert := &DedicatedServerServiceMonitoringAlertSms{}
+		if err := config.OVHClient.Get(endpoint, alert); err != nil {
+			return helpers.CheckDeleted(d, err, endpoint)
+		}
+
+		d.Set("enabled", alert.Enabled)
+		d.Set("language", alert.Language)
+		d.Set("phone_number_to", alert.PhoneNumberTo)
+		d.Set("sms_account", alert.SmsAccount)
+
+		if alert.FromHour != nil {
+			d.Set("from_hour", *alert.FromHour)
+		}
+		if alert.ToHour != nil {
+			d.Set("to_hour", *alert.ToHour)
+		}
+
+	default:
+		return fmt.Errorf("Unknown alert type %s", d.Get("type").(string))
+	}
+
+	return nil
+}
+
+func resourceDedicatedServerServiceMonitoringAlertUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := fmt.Sprintf(
+		"%s/%s",
+		dedicatedServerServiceMonitoringAlertEndpoint(d),
+		url.PathEscape(d.Id()),
+	)
+
+	var opts interface{}
+	switch d.Get("type").(string) {
+	case "email":
+		if !d.Get("enabled").(bool) {
+			return fmt.Errorf("email alerts can't be disabled, enabled only applies to sms alerts")
+		}
+		opts = (&DedicatedServerServiceMonitoringAlertEmailOpts{}).FromResource(d)
+	case "sms":
+		opts = (&DedicatedServerServiceMonitoringAlertSmsUpdateOpts{}).FromResource(d)
+	}
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return resourceDedicatedServerServiceMonitoringAlertRead(d, meta)
+}
+
+func resourceDedicatedServerServiceMonitoringAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	endpoint := fmt.Sprintf(
+		"%s/%s",
+		dedicatedServerServiceMonitoringAlertEndpoint(d),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDedicatedServerServiceMonitoringAlert_email(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerServiceMonitoringAlertConfig, serviceName, "en"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring_alert.email", "email", "tf-test@example.com"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring_alert.email", "language", "en"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerServiceMonitoringAlertConfig, serviceName, "fr"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring_alert.email", "language", "fr"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_service_monitoring_alert.email",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDedicatedServerServiceMonitoringAlertImportId("ovh_dedicated_server_service_monitoring_alert.email"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerServiceMonitoringAlertImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("service monitoring alert not found: %s", name)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s/%s",
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["monitoring_id"],
+			rs.Primary.Attributes["type"],
+			rs.Primary.ID,
+		), nil
+	}
+}
+
+const testAccDedicatedServerServiceMonitoringAlertConfig = `
+data "ovh_dedicated_server" "server" {
+  service_name = "%s"
+}
+
+resource "ovh_dedicated_server_service_monitoring" "ssh" {
+  service_name = data.ovh_dedicated_server.server.service_name
+  ip           = data.ovh_dedicated_server.server.ip
+  port         = 22
+  protocol     = "SSH"
+  interval     = "300"
+}
+
+resource "ovh_dedicated_server_service_monitoring_alert" "email" {
+  service_name  = ovh_dedicated_server_service_monitoring.ssh.service_name
+  monitoring_id = ovh_dedicated_server_service_monitoring.ssh.id
+  type          = "email"
+  email         = "tf-test@example.com"
+  language      = "%s"
+}
+`
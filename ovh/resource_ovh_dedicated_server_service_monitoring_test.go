@@ -0,0 +1,81 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDedicatedServerServiceMonitoring_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCredentials(t)
+			testAccPreCheckDedicatedServer(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerServiceMonitoringConfig, serviceName, "300", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring.ssh", "protocol", "SSH"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring.ssh", "port", "22"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring.ssh", "interval", "300"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring.ssh", "enabled", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerServiceMonitoringConfig, serviceName, "3600", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring.ssh", "interval", "3600"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_service_monitoring.ssh", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_service_monitoring.ssh",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDedicatedServerServiceMonitoringImportId("ovh_dedicated_server_service_monitoring.ssh"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerServiceMonitoringImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("service monitoring not found: %s", name)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.ID,
+		), nil
+	}
+}
+
+const testAccDedicatedServerServiceMonitoringConfig = `
+data "ovh_dedicated_server" "server" {
+  service_name = "%s"
+}
+
+resource "ovh_dedicated_server_service_monitoring" "ssh" {
+  service_name = data.ovh_dedicated_server.server.service_name
+  ip           = data.ovh_dedicated_server.server.ip
+  port         = 22
+  protocol     = "SSH"
+  interval     = "%s"
+  enabled      = %s
+}
+`
@@ -0,0 +1,252 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDedicatedServerVirtualMac() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerVirtualMacCreate,
+		Read:   resourceDedicatedServerVirtualMacRead,
+		Delete: resourceDedicatedServerVirtualMacDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerVirtualMacImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Type of the virtual MAC: ovh or vmware. Defaults to ovh for new virtual MACs",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"ovh", "vmware"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP address bound to the virtual MAC",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"virtual_machine_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the virtual machine using the IP address",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The virtual MAC. When set, the IP address is added to this existing virtual MAC",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerVirtualMacImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not SERVICE_NAME/MAC/IP formatted")
+	}
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("mac_address", splitId[1])
+	d.Set("ip_address", splitId[2])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDedicatedServerVirtualMacCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	ip := d.Get("ip_address").(string)
+
+	task := &DedicatedServerTask{}
+
+	if mac, ok := d.GetOk("mac_address"); ok {
+		// the type of an existing virtual MAC can't be changed
+		if macType, ok := d.GetOk("type"); ok {
+			virtualMac := &DedicatedServerVirtualMac{}
+			endpoint := fmt.Sprintf(
+				"/dedicated/server/%s/virtualMac/%s",
+				url.PathEscape(serviceName),
+				url.PathEscape(mac.(string)),
+			)
+
+			if err := config.OVHClient.Get(endpoint, virtualMac); err != nil {
+				return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+			}
+
+			if virtualMac.Type != macType.(string) {
+				return fmt.Errorf(
+					"Virtual MAC %s is of type %s, not %s",
+					mac.(string),
+					virtualMac.Type,
+					macType.(string),
+				)
+			}
+		}
+
+		opts := (&DedicatedServerVirtualMacAddressCreateOpts{}).FromResource(d)
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/virtualMac/%s/virtualAddress",
+			url.PathEscape(serviceName),
+			url.PathEscape(mac.(string)),
+		)
+
+		if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	} else {
+		opts := (&DedicatedServerVirtualMacCreateOpts{}).FromResource(d)
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/virtualMac",
+			url.PathEscape(serviceName),
+		)
+
+		if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	// the task doesn't tell which MAC has been generated,
+	// look for the one the IP is now bound to
+	mac, err := dedicatedServerVirtualMacFind(serviceName, ip, config)
+	if err != nil {
+		return err
+	}
+	if mac == "" {
+		return fmt.Errorf("No virtual MAC found for IP %s on dedicated server %s", ip, serviceName)
+	}
+
+	d.SetId(ip)
+	d.Set("mac_address", mac)
+
+	return resourceDedicatedServerVirtualMacRead(d, meta)
+}
+
+func resourceDedicatedServerVirtualMacRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	mac := d.Get("mac_address").(string)
+
+	virtualMac := &DedicatedServerVirtualMac{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(mac),
+	)
+
+	if err := config.OVHClient.Get(endpoint, virtualMac); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	address := &DedicatedServerVirtualMacAddress{}
+	endpoint = fmt.Sprintf(
+		"%s/virtualAddress/%s",
+		endpoint,
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, address); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("type", virtualMac.Type)
+	d.Set("mac_address", virtualMac.MacAddress)
+	d.Set("ip_address", address.IpAddress)
+	d.Set("virtual_machine_name", address.VirtualMachineName)
+
+	return nil
+}
+
+func resourceDedicatedServerVirtualMacDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	mac := d.Get("mac_address").(string)
+
+	task := &DedicatedServerTask{}
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac/%s/virtualAddress/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(mac),
+		url.PathEscape(d.Id()),
+	)
+
+	// the virtual MAC is removed along with its last IP address
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dedicatedServerVirtualMacFind returns the virtual MAC the IP is bound to,
+// or an empty string if none
+func dedicatedServerVirtualMacFind(serviceName, ip string, config *Config) (string, error) {
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac",
+		url.PathEscape(serviceName),
+	)
+
+	macs := []string{}
+	if err := config.OVHClient.Get(endpoint, &macs); err != nil {
+		return "", fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, mac := range macs {
+		macEndpoint := fmt.Sprintf("%s/%s/virtualAddress", endpoint, url.PathEscape(mac))
+		addresses := []string{}
+		if err := config.OVHClient.Get(macEndpoint, &addresses); err != nil {
+			return "", fmt.Errorf("Error calling GET %s:\n\t %q", macEndpoint, err)
+		}
+
+		for _, address := range addresses {
+			if address == ip {
+				log.Printf("[DEBUG] IP %s is bound to virtual MAC %s", ip, mac)
+				return mac, nil
+			}
+		}
+	}
+
+	return "", nil
+}
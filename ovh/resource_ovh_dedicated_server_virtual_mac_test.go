@@ -0,0 +1,67 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDedicatedServerVirtualMac_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+	ip := os.Getenv("OVH_VIRTUAL_MAC_IP")
+	vmName := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(testAccDedicatedServerVirtualMacConfig, serviceName, ip, vmName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDedicatedServerVirtualMac(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_virtual_mac.vmac", "ip_address", ip),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_virtual_mac.vmac", "type", "ovh"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_virtual_mac.vmac", "virtual_machine_name", vmName),
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_virtual_mac.vmac", "mac_address"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_virtual_mac.vmac",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDedicatedServerVirtualMacImportId("ovh_dedicated_server_virtual_mac.vmac"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerVirtualMacImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("virtual mac not found: %s", name)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			rs.Primary.Attributes["service_name"],
+			rs.Primary.Attributes["mac_address"],
+			rs.Primary.ID,
+		), nil
+	}
+}
+
+const testAccDedicatedServerVirtualMacConfig = `
+resource "ovh_dedicated_server_virtual_mac" "vmac" {
+  service_name         = "%s"
+  ip_address           = "%s"
+  virtual_machine_name = "%s"
+}
+`
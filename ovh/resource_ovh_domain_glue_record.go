@@ -0,0 +1,164 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainGlueRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainGlueRecordCreate,
+		Read:   resourceDomainGlueRecordRead,
+		Update: resourceDomainGlueRecordUpdate,
+		Delete: resourceDomainGlueRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainGlueRecordImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain name registered at OVH",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Host of the glue record, a subdomain of the domain",
+			},
+			"ips": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "IPs of the glue record",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := helpers.ValidateIp(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceDomainGlueRecordImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not DOMAIN/HOST formatted")
+	}
+	domain := splitId[0]
+	host := splitId[1]
+	d.SetId(host)
+	d.Set("domain", domain)
+	d.Set("host", host)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainGlueRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	opts := (&DomainGlueRecordCreateOpts{}).FromResource(d)
+	task := &DomainTask{}
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord",
+		url.PathEscape(domain),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(opts.Host)
+
+	return resourceDomainGlueRecordRead(d, meta)
+}
+
+func resourceDomainGlueRecordRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	glueRecord := &DomainGlueRecord{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord/%s",
+		url.PathEscape(domain),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, glueRecord); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("host", glueRecord.Host)
+	d.Set("ips", glueRecord.Ips)
+
+	return nil
+}
+
+func resourceDomainGlueRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	opts := (&DomainGlueRecordUpdateOpts{}).FromResource(d)
+	task := &DomainTask{}
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord/%s/update",
+		url.PathEscape(domain),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceDomainGlueRecordRead(d, meta)
+}
+
+func resourceDomainGlueRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	task := &DomainTask{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord/%s",
+		url.PathEscape(domain),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
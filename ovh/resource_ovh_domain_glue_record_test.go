@@ -0,0 +1,54 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainGlueRecord_basic(t *testing.T) {
+	domain := os.Getenv("OVH_ZONE_TEST")
+	host := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainGlueRecordConfig, domain, host, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.ns", "host", host),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.ns", "ips.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainGlueRecordConfig, domain, host, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.ns", "host", host),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.ns", "ips.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_glue_record.ns",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", domain, host),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccDomainGlueRecordConfig = `
+resource "ovh_domain_glue_record" "ns" {
+  domain = "%s"
+  host   = "%s"
+  ips    = ["%s"]
+}
+`
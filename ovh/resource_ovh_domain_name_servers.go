@@ -0,0 +1,132 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainNameServers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainNameServersCreateOrUpdate,
+		Read:   resourceDomainNameServersRead,
+		Update: resourceDomainNameServersCreateOrUpdate,
+		Delete: resourceDomainNameServersDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("domain", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain name registered at OVH",
+			},
+			"servers": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Name servers the domain is delegated to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Host name of the name server",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "IP of the name server, only needed when the host is a subdomain of the domain",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateIp(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainNameServersCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	opts := (&DomainNameServersUpdateOpts{}).FromResource(d)
+	task := &DomainTask{}
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/nameServers/update",
+		url.PathEscape(domain),
+	)
+
+	log.Printf("[DEBUG] Will update name servers of domain %s: %#v", domain, opts)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(domain)
+
+	return resourceDomainNameServersRead(d, meta)
+}
+
+func resourceDomainNameServersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Id()
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/nameServer",
+		url.PathEscape(domain),
+	)
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	servers := []map[string]interface{}{}
+	for _, id := range ids {
+		ns := &DomainNameServer{}
+		nsEndpoint := fmt.Sprintf("%s/%d", endpoint, id)
+
+		if err := config.OVHClient.Get(nsEndpoint, ns); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", nsEndpoint, err)
+		}
+
+		if ns.ToDelete {
+			continue
+		}
+
+		servers = append(servers, ns.ToMap())
+	}
+
+	d.Set("domain", domain)
+	d.Set("servers", servers)
+
+	return nil
+}
+
+func resourceDomainNameServersDelete(d *schema.ResourceData, meta interface{}) error {
+	// a domain can't be left without name servers,
+	// just forget about the current delegation
+	log.Printf("[WARN] Name servers of domain %s are left untouched on destroy", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainNameServers_basic(t *testing.T) {
+	domain := os.Getenv("OVH_ZONE_TEST")
+	config := fmt.Sprintf(testAccDomainNameServersConfig, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_name_servers.servers", "domain", domain),
+					resource.TestCheckResourceAttrPair(
+						"ovh_domain_name_servers.servers", "servers.#",
+						"data.ovh_domain_zone.zone", "name_servers.#",
+					),
+				),
+			},
+		},
+	})
+}
+
+// re-apply the name servers of the zone hosted at OVH
+// to leave the test domain delegation untouched
+const testAccDomainNameServersConfig = `
+data "ovh_domain_zone" "zone" {
+  name = "%s"
+}
+
+resource "ovh_domain_name_servers" "servers" {
+  domain = data.ovh_domain_zone.zone.name
+
+  dynamic "servers" {
+    for_each = data.ovh_domain_zone.zone.name_servers
+    content {
+      host = servers.value
+    }
+  }
+}
+`
@@ -0,0 +1,222 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainRenew() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainRenewCreateOrUpdate,
+		Read:   resourceDomainRenewRead,
+		Update: resourceDomainRenewCreateOrUpdate,
+		Delete: resourceDomainRenewDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("domain", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain name registered at OVH",
+			},
+			"automatic_renew": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Automatically renew the domain before its expiration",
+			},
+			"transfer_lock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Lock the domain against transfers to another registrar. Left untouched if not set",
+			},
+
+			// Computed
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the domain",
+			},
+			"renew_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Renewal period in months",
+			},
+			"transfer_lock_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Transfer lock status (locked, locking, unavailable, unlocked, unlocking)",
+			},
+		},
+	}
+}
+
+func resourceDomainRenewCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	// renewal
+	serviceInfos := &ServiceInfos{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s/serviceInfos",
+		url.PathEscape(domain),
+	)
+
+	if err := config.OVHClient.Get(endpoint, serviceInfos); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	automatic := d.Get("automatic_renew").(bool)
+	if serviceInfos.Renew == nil || serviceInfos.Renew.Automatic != automatic {
+		renew := serviceInfos.Renew
+		if renew == nil {
+			renew = &ServiceInfosRenew{}
+		}
+		renew.Automatic = automatic
+		if automatic {
+			renew.DeleteAtExpiration = false
+		}
+
+		opts := &ServiceInfosUpdateOpts{Renew: renew}
+		log.Printf("[DEBUG] Will update renewal of domain %s: %#v", domain, renew)
+
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	// transfer lock, only when managed
+	if v, ok := d.GetOkExists("transfer_lock"); ok && (d.Id() == "" || d.HasChange("transfer_lock")) {
+		transferLockStatus := "unlocked"
+		if v.(bool) {
+			transferLockStatus = "locked"
+		}
+
+		if err := updateDomainTransferLock(domain, transferLockStatus, meta); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(domain)
+
+	return resourceDomainRenewRead(d, meta)
+}
+
+func resourceDomainRenewRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domainName := d.Id()
+
+	domain := &Domain{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s",
+		url.PathEscape(domainName),
+	)
+
+	if err := config.OVHClient.Get(endpoint, domain); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	serviceInfos := &ServiceInfos{}
+	endpoint = fmt.Sprintf(
+		"/domain/%s/serviceInfos",
+		url.PathEscape(domainName),
+	)
+
+	if err := config.OVHClient.Get(endpoint, serviceInfos); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("domain", domain.Domain)
+	d.Set("expiration", serviceInfos.Expiration)
+	d.Set("transfer_lock_status", domain.TransferLockStatus)
+
+	switch domain.TransferLockStatus {
+	case "locked", "locking":
+		d.Set("transfer_lock", true)
+	case "unlocked", "unlocking", "unavailable":
+		// a domain which can't be locked is seen as unlocked
+		d.Set("transfer_lock", false)
+	}
+
+	if serviceInfos.Renew != nil {
+		d.Set("automatic_renew", serviceInfos.Renew.Automatic)
+		if serviceInfos.Renew.Period != nil {
+			d.Set("renew_period", *serviceInfos.Renew.Period)
+		}
+	}
+
+	return nil
+}
+
+func resourceDomainRenewDelete(d *schema.ResourceData, meta interface{}) error {
+	// renewal and transfer lock are left untouched,
+	// just forget about them
+	d.SetId("")
+	return nil
+}
+
+func updateDomainTransferLock(domainName, status string, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s",
+		url.PathEscape(domainName),
+	)
+
+	domain := &Domain{}
+	if err := config.OVHClient.Get(endpoint, domain); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	if domain.TransferLockStatus == status {
+		return nil
+	}
+
+	if domain.TransferLockStatus == "unavailable" {
+		// nothing to unlock
+		if status == "unlocked" {
+			return nil
+		}
+		return fmt.Errorf("Transfer lock is unavailable for domain %s", domainName)
+	}
+
+	opts := &DomainUpdateOpts{TransferLockStatus: status}
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"locking", "unlocking"},
+		Target:  []string{status},
+		Refresh: func() (interface{}, string, error) {
+			domain := &Domain{}
+			if err := config.OVHClient.Get(endpoint, domain); err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Transfer lock of domain %s: %s", domainName, domain.TransferLockStatus)
+			return domain, domain.TransferLockStatus, nil
+		},
+		Timeout:    20 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for transfer lock of domain %s to be %s: %s", domainName, status, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainRenew_basic(t *testing.T) {
+	domain := os.Getenv("OVH_ZONE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainRenewConfig, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_renew.domain", "automatic_renew", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_renew.domain", "transfer_lock", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_renew.domain", "transfer_lock_status", "locked"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_domain_renew.domain", "expiration"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_renew.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccDomainRenewConfig = `
+resource "ovh_domain_renew" "domain" {
+  domain          = "%s"
+  automatic_renew = true
+  transfer_lock   = true
+}
+`
@@ -0,0 +1,216 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneCreate,
+		Read:   resourceDomainZoneRead,
+		Update: resourceDomainZoneUpdate,
+		Delete: resourceDomainZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the domain zone",
+			},
+			"expected_dns_anycast": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Asserts the state of the DNS anycast option of the zone. The option itself isn't ordered nor terminated",
+			},
+			"reset_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Reset the zone to its default records when the resource is created",
+			},
+			"reset_minimized": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Reset the zone with a minimal set of records",
+			},
+			"reset_records": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Records the zone is reset with, instead of the OVH default ones",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fieldtype": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Type of the record (A, MX)",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateStringEnum(v.(string), []string{"A", "MX"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Target of the record",
+						},
+					},
+				},
+			},
+
+			// Computed
+			"has_dns_anycast": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "hasDnsAnycast flag of the DNS zone",
+			},
+			"dnssec_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is DNSSEC supported by this zone",
+			},
+			"name_servers": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Name servers that host the DNS zone",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"last_update": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update date of the DNS zone",
+			},
+		},
+	}
+}
+
+func resourceDomainZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zoneName := d.Get("name").(string)
+
+	// zones are ordered, the resource only takes control over an existing one
+	dz, err := getDomainZone(zoneName, config.OVHClient)
+	if err != nil {
+		return fmt.Errorf("Error calling GET /domain/zone/%s:\n\t %q", zoneName, err)
+	}
+
+	if err := checkDomainZoneDnsAnycast(d, dz); err != nil {
+		return err
+	}
+
+	if d.Get("reset_on_create").(bool) {
+		opts := (&DomainZoneResetOpts{}).FromResource(d)
+		endpoint := fmt.Sprintf(
+			"/domain/zone/%s/reset",
+			url.PathEscape(zoneName),
+		)
+
+		log.Printf("[INFO] Will reset zone %s: %#v", zoneName, opts)
+
+		if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	d.SetId(zoneName)
+
+	if d.Get("reset_on_create").(bool) {
+		if err := ovhDomainZoneRefresh(d, meta); err != nil {
+			log.Printf("[WARN] OVH Domain zone refresh after zone reset failed: %s", err)
+		}
+	}
+
+	return resourceDomainZoneRead(d, meta)
+}
+
+func resourceDomainZoneRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zoneName := d.Id()
+
+	dz, err := getDomainZone(zoneName, config.OVHClient)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, fmt.Sprintf("/domain/zone/%s", zoneName))
+	}
+
+	d.Set("name", zoneName)
+	d.Set("expected_dns_anycast", dz.HasDnsAnycast)
+	d.Set("has_dns_anycast", dz.HasDnsAnycast)
+	d.Set("dnssec_supported", dz.DnssecSupported)
+	d.Set("last_update", dz.LastUpdate)
+	d.Set("name_servers", dz.NameServers)
+
+	return nil
+}
+
+func resourceDomainZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	dz, err := getDomainZone(d.Id(), config.OVHClient)
+	if err != nil {
+		return fmt.Errorf("Error calling GET /domain/zone/%s:\n\t %q", d.Id(), err)
+	}
+
+	if err := checkDomainZoneDnsAnycast(d, dz); err != nil {
+		return err
+	}
+
+	return resourceDomainZoneRead(d, meta)
+}
+
+func resourceDomainZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	// zones are terminated with their service, just forget about it
+	d.SetId("")
+	return nil
+}
+
+func getDomainZone(zoneName string, c *ovh.Client) (*DomainZone, error) {
+	dz := &DomainZone{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s",
+		url.PathEscape(zoneName),
+	)
+
+	if err := c.Get(endpoint, dz); err != nil {
+		return nil, err
+	}
+
+	return dz, nil
+}
+
+// DNS anycast is a paid option which can't be toggled through the zone itself.
+// expected_dns_anycast is only an assertion: a drift shows up in plans and fails on apply.
+func checkDomainZoneDnsAnycast(d *schema.ResourceData, dz *DomainZone) error {
+	v, ok := d.GetOkExists("expected_dns_anycast")
+	if !ok || v.(bool) == dz.HasDnsAnycast {
+		return nil
+	}
+
+	if v.(bool) {
+		return fmt.Errorf("DNS anycast is not enabled on zone %s. It must be ordered as a zone option", d.Get("name").(string))
+	}
+
+	return fmt.Errorf("DNS anycast is enabled on zone %s. It must be terminated as a zone option", d.Get("name").(string))
+}
@@ -0,0 +1,164 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZoneDynHostLogin() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneDynHostLoginCreate,
+		Read:   resourceDomainZoneDynHostLoginRead,
+		Update: resourceDomainZoneDynHostLoginUpdate,
+		Delete: resourceDomainZoneDynHostLoginDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainZoneDynHostLoginImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone of the DynHost login",
+			},
+			"login_suffix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Suffix appended to the zone name to build the login",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the DynHost login. It is never read back from the API",
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Subdomain pattern the login is allowed to update (ex: \"*\" or \"office-*\")",
+			},
+
+			// Computed
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full DynHost login",
+			},
+		},
+	}
+}
+
+func resourceDomainZoneDynHostLoginImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not ZONE/LOGIN formatted")
+	}
+	zone := splitId[0]
+	login := splitId[1]
+	d.SetId(login)
+	d.Set("zone", zone)
+	d.Set("login_suffix", strings.TrimPrefix(login, zone+"-"))
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainZoneDynHostLoginCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	opts := (&DomainZoneDynHostLoginCreateOpts{}).FromResource(d)
+	login := &DomainZoneDynHostLogin{}
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login",
+		url.PathEscape(zone),
+	)
+
+	// opts are not logged as they contain the password
+	if err := config.OVHClient.Post(endpoint, opts, login); err != nil {
+		return fmt.Errorf("Error calling POST %s for login suffix %s:\n\t %q", endpoint, opts.LoginSuffix, err)
+	}
+
+	d.SetId(login.Login)
+
+	return resourceDomainZoneDynHostLoginRead(d, meta)
+}
+
+func resourceDomainZoneDynHostLoginRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	login := &DomainZoneDynHostLogin{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, login); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("zone", login.Zone)
+	d.Set("login", login.Login)
+	d.Set("subdomain", login.SubDomain)
+
+	return nil
+}
+
+func resourceDomainZoneDynHostLoginUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if d.HasChange("subdomain") {
+		opts := (&DomainZoneDynHostLoginUpdateOpts{}).FromResource(d)
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	if d.HasChange("password") {
+		opts := &DomainZoneDynHostLoginChangePasswordOpts{
+			Password: d.Get("password").(string),
+		}
+		passwordEndpoint := fmt.Sprintf("%s/changePassword", endpoint)
+		if err := config.OVHClient.Post(passwordEndpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling POST %s:\n\t %q", passwordEndpoint, err)
+		}
+	}
+
+	return resourceDomainZoneDynHostLoginRead(d, meta)
+}
+
+func resourceDomainZoneDynHostLoginDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneDynHostLogin_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+	suffix := acctest.RandString(8)
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostLoginConfig, zone, suffix, "Test-Passw0rd-1", subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_login.office", "login", fmt.Sprintf("%s-%s", zone, suffix)),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_login.office", "subdomain", subdomain),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostLoginConfig, zone, suffix, "Test-Passw0rd-2", "*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_login.office", "subdomain", "*"),
+				),
+			},
+			{
+				ResourceName:            "ovh_domain_zone_dynhost_login.office",
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zone),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+const testAccDomainZoneDynHostLoginConfig = `
+resource "ovh_domain_zone_dynhost_login" "office" {
+  zone         = "%s"
+  login_suffix = "%s"
+  password     = "%s"
+  subdomain    = "%s"
+}
+`
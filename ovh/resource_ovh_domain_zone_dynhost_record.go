@@ -0,0 +1,162 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZoneDynHostRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneDynHostRecordCreate,
+		Read:   resourceDomainZoneDynHostRecordRead,
+		Update: resourceDomainZoneDynHostRecordUpdate,
+		Delete: resourceDomainZoneDynHostRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainZoneDynHostRecordImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone of the DynHost record",
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Subdomain of the DynHost record",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IP address of the DynHost record",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIp(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "TTL of the DynHost record",
+			},
+		},
+	}
+}
+
+func resourceDomainZoneDynHostRecordImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not ZONE/ID formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("zone", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainZoneDynHostRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	opts := (&DomainZoneDynHostRecordOpts{}).FromResource(d)
+	record := &DomainZoneDynHostRecord{}
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record",
+		url.PathEscape(zone),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, record); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", record.Id))
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after DynHost record creation failed: %s", err)
+	}
+
+	return resourceDomainZoneDynHostRecordRead(d, meta)
+}
+
+func resourceDomainZoneDynHostRecordRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	record := &DomainZoneDynHostRecord{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, record); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("zone", record.Zone)
+	d.Set("subdomain", record.SubDomain)
+	d.Set("ip", record.Ip)
+	d.Set("ttl", record.Ttl)
+
+	return nil
+}
+
+func resourceDomainZoneDynHostRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	opts := (&DomainZoneDynHostRecordOpts{}).FromResource(d)
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after DynHost record update failed: %s", err)
+	}
+
+	return resourceDomainZoneDynHostRecordRead(d, meta)
+}
+
+func resourceDomainZoneDynHostRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after DynHost record deletion failed: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
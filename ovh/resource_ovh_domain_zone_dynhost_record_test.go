@@ -0,0 +1,113 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("ovh_domain_zone_dynhost_record", &resource.Sweeper{
+		Name: "ovh_domain_zone_dynhost_record",
+		F:    testSweepDomainZoneDynHostRecord,
+	})
+}
+
+func testSweepDomainZoneDynHostRecord(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	zoneName := os.Getenv("OVH_ZONE_TEST")
+	if zoneName == "" {
+		log.Print("[DEBUG] OVH_ZONE_TEST is not set. No zone to sweep")
+		return nil
+	}
+
+	records := make([]int64, 0)
+	if err := client.Get(fmt.Sprintf("/domain/zone/%s/dynHost/record", zoneName), &records); err != nil {
+		return fmt.Errorf("Error calling /domain/zone/%s/dynHost/record:\n\t %q", zoneName, err)
+	}
+
+	if len(records) == 0 {
+		log.Print("[DEBUG] No DynHost record to sweep")
+		return nil
+	}
+
+	for _, id := range records {
+		record := &DomainZoneDynHostRecord{}
+		endpoint := fmt.Sprintf("/domain/zone/%s/dynHost/record/%d", zoneName, id)
+
+		if err := client.Get(endpoint, record); err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+
+		if !strings.HasPrefix(record.SubDomain, test_prefix) {
+			continue
+		}
+
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if err := client.Delete(endpoint, nil); err != nil {
+				return resource.RetryableError(err)
+			}
+			// Successful delete
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAccDomainZoneDynHostRecord_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostRecordConfig, zone, subdomain, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.office", "subdomain", subdomain),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.office", "ip", "192.0.2.1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostRecordConfig, zone, subdomain, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.office", "subdomain", subdomain),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.office", "ip", "192.0.2.2"),
+				),
+			},
+			{
+				ResourceName:        "ovh_domain_zone_dynhost_record.office",
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zone),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+const testAccDomainZoneDynHostRecordConfig = `
+resource "ovh_domain_zone_dynhost_record" "office" {
+  zone      = "%s"
+  subdomain = "%s"
+  ip        = "%s"
+}
+`
@@ -3,6 +3,7 @@ package ovh
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,13 +35,46 @@ func (r *OvhDomainZoneRecord) String() string {
 	)
 }
 
+func (r OvhDomainZoneRecord) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["id"] = int(r.Id)
+	obj["subdomain"] = r.SubDomain
+	obj["fieldtype"] = r.FieldType
+	obj["ttl"] = r.Ttl
+	obj["target"] = r.Target
+
+	return obj
+}
+
 func resourceOvhDomainZoneRecordImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
 	givenId := d.Id()
+
+	// natural key: zone/subdomain/fieldtype/target
+	if strings.Contains(givenId, "/") {
+		splitId := strings.SplitN(givenId, "/", 4)
+		if len(splitId) != 4 {
+			return nil, fmt.Errorf("Import Id is not zone/subdomain/fieldtype/target formatted")
+		}
+
+		provider := meta.(*Config)
+		record, err := ovhDomainZoneRecordLookup(provider.OVHClient, splitId[0], splitId[1], splitId[2], splitId[3])
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, fmt.Errorf("No record found matching import id %s", givenId)
+		}
+
+		d.SetId(strconv.FormatInt(record.Id, 10))
+		d.Set("zone", splitId[0])
+		return []*schema.ResourceData{d}, nil
+	}
+
 	splitId := strings.SplitN(givenId, ".", 2)
 	if len(splitId) != 2 {
-		return nil, fmt.Errorf("Import Id is not OVH_ID.zone formatted")
+		return nil, fmt.Errorf("Import Id is not OVH_ID.zone or zone/subdomain/fieldtype/target formatted")
 	}
 	d.SetId(splitId[0])
 	d.Set("zone", splitId[1])
@@ -82,6 +116,11 @@ func resourceOvhDomainZoneRecord() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Adopt an existing record with the same zone, subdomain, fieldtype and target instead of creating a duplicate",
+			},
 		},
 	}
 }
@@ -100,6 +139,23 @@ func resourceOvhDomainZoneRecordCreate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] OVH Record create configuration: %#v", newRecord)
 
+	if d.Get("adopt_existing").(bool) {
+		existing, err := ovhDomainZoneRecordLookup(provider.OVHClient, zone, newRecord.SubDomain, newRecord.FieldType, newRecord.Target)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			log.Printf("[INFO] Adopting existing OVH Record %s", existing)
+			d.SetId(strconv.FormatInt(existing.Id, 10))
+
+			if existing.Ttl != newRecord.Ttl {
+				return resourceOvhDomainZoneRecordUpdate(d, meta)
+			}
+			return resourceOvhDomainZoneRecordRead(d, meta)
+		}
+	}
+
 	resultRecord := &OvhDomainZoneRecord{}
 
 	err := provider.OVHClient.Post(
@@ -274,3 +330,67 @@ func ovhDomainZoneRecord(client *ovh.Client, d *schema.ResourceData, id string,
 
 	return rec, err
 }
+
+// ovhDomainZoneRecordLookup returns the record of the zone matching the given
+// subdomain, fieldtype and target, or nil if there is none. An empty subdomain
+// matches the records of the zone apex. An error is returned if several
+// records match.
+func ovhDomainZoneRecordLookup(client *ovh.Client, zone, subdomain, fieldType, target string) (*OvhDomainZoneRecord, error) {
+	query := url.Values{}
+	query.Set("fieldType", fieldType)
+	if subdomain != "" {
+		query.Set("subDomain", subdomain)
+	}
+
+	endpoint := fmt.Sprintf("/domain/zone/%s/record?%s", url.PathEscape(zone), query.Encode())
+
+	ids := []int64{}
+	if err := client.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	records := make([]*OvhDomainZoneRecord, len(ids))
+	err := helpers.ParallelFor(len(ids), domainZoneRecordsFetchWorkers, func(i int) error {
+		record := &OvhDomainZoneRecord{}
+		recordEndpoint := fmt.Sprintf("/domain/zone/%s/record/%d", url.PathEscape(zone), ids[i])
+		if err := client.Get(recordEndpoint, record); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", recordEndpoint, err)
+		}
+
+		records[i] = record
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*OvhDomainZoneRecord{}
+	for _, record := range records {
+		if record.SubDomain == subdomain &&
+			record.FieldType == fieldType &&
+			record.Target == target {
+			matches = append(matches, record)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	}
+
+	candidates := make([]string, len(matches))
+	for i, match := range matches {
+		candidates[i] = match.String()
+	}
+
+	return nil, fmt.Errorf(
+		"Several records match %s/%s/%s/%s, use the OVH_ID.zone format to pick one of:\n\t%s",
+		zone,
+		subdomain,
+		fieldType,
+		target,
+		strings.Join(candidates, "\n\t"),
+	)
+}
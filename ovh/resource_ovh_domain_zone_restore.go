@@ -0,0 +1,119 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDomainZoneRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneRestoreCreate,
+		Read:   resourceDomainZoneRestoreRead,
+		Delete: resourceDomainZoneRestoreDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the domain zone",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Creation date of the snapshot to restore",
+			},
+			"keepers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Change this value to restore the snapshot again.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			//Computed
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Details of the restore task",
+			},
+			"done_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Completion date",
+			},
+			"function": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Function name",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Task status",
+			},
+		},
+	}
+}
+
+func resourceDomainZoneRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	creationDate := d.Get("creation_date").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/history/%s/restore",
+		url.PathEscape(zone),
+		url.PathEscape(creationDate),
+	)
+
+	log.Printf("[INFO] Restoring zone %s to snapshot %s", zone, creationDate)
+
+	task := &DomainTask{}
+	if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainZoneTask(zone, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", task.Id))
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after restore failed: %s", err)
+	}
+
+	task, err := getDomainZoneTask(zone, task.Id, config.OVHClient)
+	if err != nil {
+		// the restore is done, task details are informational
+		log.Printf("[WARN] Could not read restore task %s of zone %s: %s", d.Id(), zone, err)
+		return nil
+	}
+
+	d.Set("function", task.Function)
+	d.Set("status", task.Status)
+	d.Set("done_date", task.DoneDate.Format(time.RFC3339))
+	if task.Comment != nil {
+		d.Set("comment", *task.Comment)
+	}
+
+	return nil
+}
+
+func resourceDomainZoneRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDomainZoneRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	// a restore can't be undone, just forget about it
+	d.SetId("")
+	return nil
+}
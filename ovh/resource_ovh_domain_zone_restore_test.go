@@ -0,0 +1,48 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneRestore_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+	config := fmt.Sprintf(testAccDomainZoneRestoreConfig, zone)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_restore.restore", "zone", zone),
+					resource.TestCheckResourceAttrPair(
+						"ovh_domain_zone_restore.restore", "creation_date",
+						"data.ovh_domain_zone_history.history", "snapshots.0.creation_date",
+					),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_restore.restore", "status", "done"),
+				),
+			},
+		},
+	})
+}
+
+// restoring the most recent snapshot leaves the zone unchanged
+const testAccDomainZoneRestoreConfig = `
+data "ovh_domain_zone_history" "history" {
+  zone          = "%s"
+  fetch_content = false
+}
+
+resource "ovh_domain_zone_restore" "restore" {
+  zone          = data.ovh_domain_zone_history.history.zone
+  creation_date = data.ovh_domain_zone_history.history.snapshots[0].creation_date
+  keepers       = ["1"]
+}
+`
@@ -0,0 +1,141 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZoneSoa() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneSoaCreateOrUpdate,
+		Read:   resourceDomainZoneSoaRead,
+		Update: resourceDomainZoneSoaCreateOrUpdate,
+		Delete: resourceDomainZoneSoaDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("zone", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone name",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Contact email of the zone",
+			},
+			"expire": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in seconds after which secondary servers stop answering if the primary is unreachable",
+			},
+			"nx_domain_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Negative answers TTL in seconds",
+			},
+			"refresh": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in seconds between two zone refreshes by the secondary servers",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default TTL of the zone in seconds",
+			},
+
+			// Computed
+			"serial": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Serial number of the zone",
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary name server of the zone",
+			},
+		},
+	}
+}
+
+func resourceDomainZoneSoaCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/soa",
+		url.PathEscape(zone),
+	)
+
+	// the API expects the whole SOA, start from the current one
+	// so that unset attributes keep their value
+	soa := &DomainZoneSoa{}
+	if err := config.OVHClient.Get(endpoint, soa); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	opts := soa.FromResource(d)
+
+	log.Printf("[DEBUG] Will update SOA of zone %s: %#v", zone, opts)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(zone)
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after SOA update failed: %s", err)
+	}
+
+	return resourceDomainZoneSoaRead(d, meta)
+}
+
+func resourceDomainZoneSoaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Id()
+
+	soa := &DomainZoneSoa{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/soa",
+		url.PathEscape(zone),
+	)
+
+	if err := config.OVHClient.Get(endpoint, soa); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("zone", zone)
+	d.Set("email", soa.Email)
+	d.Set("expire", soa.Expire)
+	d.Set("nx_domain_ttl", soa.NxDomainTtl)
+	d.Set("refresh", soa.Refresh)
+	d.Set("ttl", soa.Ttl)
+	d.Set("serial", soa.Serial)
+	d.Set("server", soa.Server)
+
+	return nil
+}
+
+func resourceDomainZoneSoaDelete(d *schema.ResourceData, meta interface{}) error {
+	// the SOA of a zone can't be deleted, just forget about it
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,56 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneSoa_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneSoaConfig, zone, 86400, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "refresh", "86400"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "ttl", "3600"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_domain_zone_soa.soa", "server"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_domain_zone_soa.soa", "email"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainZoneSoaConfig, zone, 43200, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "refresh", "43200"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:            "ovh_domain_zone_soa.soa",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"serial"},
+			},
+		},
+	})
+}
+
+const testAccDomainZoneSoaConfig = `
+resource "ovh_domain_zone_soa" "soa" {
+  zone    = "%s"
+  refresh = %d
+  ttl     = %d
+}
+`
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZone_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneConfig, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone.zone", "id", zone),
+					resource.TestCheckResourceAttrPair(
+						"ovh_domain_zone.zone", "has_dns_anycast",
+						"data.ovh_domain_zone.zone", "has_dns_anycast",
+					),
+					resource.TestCheckResourceAttrPair(
+						"ovh_domain_zone.zone", "name_servers.#",
+						"data.ovh_domain_zone.zone", "name_servers.#",
+					),
+				),
+			},
+			{
+				ResourceName:            "ovh_domain_zone.zone",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reset_on_create", "reset_minimized"},
+			},
+		},
+	})
+}
+
+const testAccDomainZoneConfig = `
+data "ovh_domain_zone" "zone" {
+  name = "%s"
+}
+
+resource "ovh_domain_zone" "zone" {
+  name = data.ovh_domain_zone.zone.name
+}
+`
@@ -0,0 +1,363 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// allocations are recorded in the description of the block after this marker,
+// as a list of ip=name entries separated by semicolons
+const ipBlockAllocationMarker = "tf-alloc:"
+
+// longest description OVH accepts for an IP block
+const ipBlockAllocationMaxDescriptionLength = 255
+
+var ipBlockAllocationNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// allocations of a block are serialized, as concurrent allocations
+// would both pick the same address and overwrite each other's description
+var ipBlockAllocationLocks = struct {
+	sync.Mutex
+	blocks map[string]*sync.Mutex
+}{blocks: make(map[string]*sync.Mutex)}
+
+func ipBlockAllocationLock(block string) func() {
+	ipBlockAllocationLocks.Lock()
+	lock, ok := ipBlockAllocationLocks.blocks[block]
+	if !ok {
+		lock = &sync.Mutex{}
+		ipBlockAllocationLocks.blocks[block] = lock
+	}
+	ipBlockAllocationLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+func resourceIpBlockAllocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpBlockAllocationCreate,
+		Read:   resourceIpBlockAllocationRead,
+		Delete: resourceIpBlockAllocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpBlockAllocationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block to allocate an address from",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the allocation",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if !ipBlockAllocationNameRegexp.MatchString(v.(string)) {
+						errors = append(errors, fmt.Errorf("Value %s must only contain letters, digits, dots, dashes and underscores", v.(string)))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The allocated address",
+			},
+		},
+	}
+}
+
+func resourceIpBlockAllocationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/ADDRESS formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	address := splitId[2]
+	d.SetId(address)
+	d.Set("ip", block)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpBlockAllocationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	name := d.Get("name").(string)
+
+	unlock := ipBlockAllocationLock(block)
+	defer unlock()
+
+	ip, err := getIp(block, config)
+	if err != nil {
+		return err
+	}
+
+	text, allocations := ipBlockAllocationParse(ip.Description)
+	for address, n := range allocations {
+		if n == name {
+			return fmt.Errorf("Name %s is already allocated address %s in block %s", name, address, block)
+		}
+	}
+
+	used, err := ipBlockAllocationUsedAddresses(block, ip, config)
+	if err != nil {
+		return err
+	}
+	for address := range allocations {
+		used[address] = true
+	}
+
+	address, err := ipBlockAllocationNextFree(block, used)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Allocating address %s of block %s to %s", address, block, name)
+
+	allocations[address] = name
+	if err := ipBlockAllocationSave(block, text, allocations, config); err != nil {
+		return err
+	}
+
+	d.SetId(address)
+
+	return resourceIpBlockAllocationRead(d, meta)
+}
+
+func resourceIpBlockAllocationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	ip := &Ip{}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(block))
+	if err := config.OVHClient.Get(endpoint, ip); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	_, allocations := ipBlockAllocationParse(ip.Description)
+	name, ok := allocations[d.Id()]
+	if !ok {
+		log.Printf("[WARN] Address %s is no longer allocated in block %s", d.Id(), block)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("address", d.Id())
+
+	return nil
+}
+
+func resourceIpBlockAllocationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	unlock := ipBlockAllocationLock(block)
+	defer unlock()
+
+	ip, err := getIp(block, config)
+	if err != nil {
+		return err
+	}
+
+	text, allocations := ipBlockAllocationParse(ip.Description)
+	if _, ok := allocations[d.Id()]; ok {
+		log.Printf("[INFO] Releasing address %s of block %s", d.Id(), block)
+
+		delete(allocations, d.Id())
+		if err := ipBlockAllocationSave(block, text, allocations, config); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// ipBlockAllocationParse splits the description of a block between
+// its free text and the allocations recorded after the marker
+func ipBlockAllocationParse(description *string) (string, map[string]string) {
+	allocations := make(map[string]string)
+	if description == nil {
+		return "", allocations
+	}
+
+	text := *description
+	i := strings.Index(text, ipBlockAllocationMarker)
+	if i < 0 {
+		return text, allocations
+	}
+
+	for _, entry := range strings.Split(text[i+len(ipBlockAllocationMarker):], ";") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) == 2 && parts[0] != "" {
+			allocations[parts[0]] = parts[1]
+		}
+	}
+
+	return strings.TrimSpace(text[:i]), allocations
+}
+
+func ipBlockAllocationFormat(text string, allocations map[string]string) string {
+	if len(allocations) == 0 {
+		return text
+	}
+
+	addresses := make([]string, 0, len(allocations))
+	for address := range allocations {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	entries := make([]string, len(addresses))
+	for i, address := range addresses {
+		entries[i] = fmt.Sprintf("%s=%s", address, allocations[address])
+	}
+
+	description := ipBlockAllocationMarker + strings.Join(entries, ";")
+	if text != "" {
+		description = text + " " + description
+	}
+	return description
+}
+
+func ipBlockAllocationSave(block, text string, allocations map[string]string, config *Config) error {
+	description := ipBlockAllocationFormat(text, allocations)
+	if len(description) > ipBlockAllocationMaxDescriptionLength {
+		return fmt.Errorf(
+			"Allocations of block %s don't fit in its description: %d characters needed, %d allowed. Use shorter names or a shorter description",
+			block,
+			len(description),
+			ipBlockAllocationMaxDescriptionLength,
+		)
+	}
+
+	opts := &IpUpdateOpts{
+		Description: description,
+	}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(block))
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return nil
+}
+
+// ipBlockAllocationUsedAddresses lists the addresses of the block
+// which already carry a reverse or a virtual MAC
+func ipBlockAllocationUsedAddresses(block string, ip *Ip, config *Config) (map[string]bool, error) {
+	used := make(map[string]bool)
+
+	endpoint := fmt.Sprintf("/ip/%s/reverse", url.PathEscape(block))
+	reverses := []string{}
+	if err := config.OVHClient.Get(endpoint, &reverses); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+	for _, address := range reverses {
+		used[address] = true
+	}
+
+	// only IPs routed to a dedicated server may carry virtual MACs
+	if ip.RoutedTo.ServiceName == nil {
+		return used, nil
+	}
+
+	serviceName := *ip.RoutedTo.ServiceName
+	endpoint = fmt.Sprintf("/dedicated/server/%s/virtualMac", url.PathEscape(serviceName))
+	macs := []string{}
+	if err := config.OVHClient.Get(endpoint, &macs); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return used, nil
+		}
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, mac := range macs {
+		macEndpoint := fmt.Sprintf("%s/%s/virtualAddress", endpoint, url.PathEscape(mac))
+		addresses := []string{}
+		if err := config.OVHClient.Get(macEndpoint, &addresses); err != nil {
+			return nil, fmt.Errorf("Error calling GET %s:\n\t %q", macEndpoint, err)
+		}
+		for _, address := range addresses {
+			used[address] = true
+		}
+	}
+
+	return used, nil
+}
+
+// ipBlockAllocationNextFree returns the lowest address of block which is not used,
+// leaving out the network and broadcast addresses of IPv4 blocks
+func ipBlockAllocationNextFree(block string, used map[string]bool) (string, error) {
+	_, network, err := net.ParseCIDR(block)
+	if err != nil {
+		return "", err
+	}
+
+	ones, bits := network.Mask.Size()
+	reserveEdges := network.IP.To4() != nil && bits-ones > 1
+
+	address := make(net.IP, len(network.IP))
+	copy(address, network.IP)
+	if reserveEdges {
+		address = ipBlockAllocationIncrement(address)
+	}
+
+	for ; network.Contains(address); address = ipBlockAllocationIncrement(address) {
+		next := ipBlockAllocationIncrement(address)
+		if reserveEdges && !network.Contains(next) {
+			// broadcast address
+			break
+		}
+		if !used[address.String()] {
+			return address.String(), nil
+		}
+		if next.Equal(network.IP) {
+			// wrapped around the address space
+			break
+		}
+	}
+
+	return "", fmt.Errorf("No free address left in block %s", block)
+}
+
+func ipBlockAllocationIncrement(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
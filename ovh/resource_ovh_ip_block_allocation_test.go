@@ -0,0 +1,138 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpBlockAllocation_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	name := acctest.RandomWithPrefix(test_prefix)
+	config := fmt.Sprintf(testAccIpBlockAllocationConfig, block, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"ovh_ip_block_allocation.first", "address", regexp.MustCompile(`^[0-9a-f.:]+$`)),
+					resource.TestMatchResourceAttr(
+						"ovh_ip_block_allocation.second", "address", regexp.MustCompile(`^[0-9a-f.:]+$`)),
+					testAccCheckIpBlockAllocationDistinct(
+						"ovh_ip_block_allocation.first", "ovh_ip_block_allocation.second"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_block_allocation.first",
+				ImportState:       true,
+				ImportStateIdFunc: testAccIpBlockAllocationImportId("ovh_ip_block_allocation.first"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpBlockAllocationDistinct(first, second string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		a := s.RootModule().Resources[first].Primary.Attributes["address"]
+		b := s.RootModule().Resources[second].Primary.Attributes["address"]
+		if a == b {
+			return fmt.Errorf("%s and %s were both allocated %s", first, second, a)
+		}
+		return nil
+	}
+}
+
+func testAccIpBlockAllocationImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("allocation not found: %s", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["ip"], rs.Primary.ID), nil
+	}
+}
+
+func TestIpBlockAllocationParse(t *testing.T) {
+	description := "frontends tf-alloc:192.0.2.1=web;192.0.2.2=mail"
+	text, allocations := ipBlockAllocationParse(&description)
+	if text != "frontends" {
+		t.Fatalf("unexpected text %q", text)
+	}
+
+	expected := map[string]string{"192.0.2.1": "web", "192.0.2.2": "mail"}
+	if !reflect.DeepEqual(allocations, expected) {
+		t.Fatalf("expected %v, got %v", expected, allocations)
+	}
+
+	if v := ipBlockAllocationFormat(text, allocations); v != description {
+		t.Fatalf("expected %q, got %q", description, v)
+	}
+
+	if v := ipBlockAllocationFormat("frontends", map[string]string{}); v != "frontends" {
+		t.Fatalf("unexpected description without allocations %q", v)
+	}
+}
+
+func TestIpBlockAllocationNextFree(t *testing.T) {
+	cases := []struct {
+		block    string
+		used     []string
+		expected string
+	}{
+		{"192.0.2.0/29", nil, "192.0.2.1"},
+		{"192.0.2.0/29", []string{"192.0.2.1", "192.0.2.3"}, "192.0.2.2"},
+		{"192.0.2.42/32", nil, "192.0.2.42"},
+		{"2001:db8::/64", []string{"2001:db8::"}, "2001:db8::1"},
+	}
+
+	for _, c := range cases {
+		used := make(map[string]bool)
+		for _, address := range c.used {
+			used[address] = true
+		}
+
+		address, err := ipBlockAllocationNextFree(c.block, used)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %s", c.block, err)
+		}
+		if address != c.expected {
+			t.Fatalf("%s: expected %s, got %s", c.block, c.expected, address)
+		}
+	}
+
+	used := map[string]bool{
+		"192.0.2.1": true,
+		"192.0.2.2": true,
+	}
+	if _, err := ipBlockAllocationNextFree("192.0.2.0/30", used); err == nil {
+		t.Fatalf("expected an error on a full block")
+	}
+}
+
+// both allocations are created concurrently
+const testAccIpBlockAllocationConfig = `
+locals {
+  block = "%s"
+}
+
+resource "ovh_ip_block_allocation" "first" {
+  ip   = local.block
+  name = "%s-first"
+}
+
+resource "ovh_ip_block_allocation" "second" {
+  ip   = local.block
+  name = "%s-second"
+}
+`
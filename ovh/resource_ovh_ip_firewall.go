@@ -0,0 +1,225 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpFirewallCreate,
+		Read:   resourceIpFirewallRead,
+		Update: resourceIpFirewallUpdate,
+		Delete: resourceIpFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpFirewallImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the IP belongs to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_firewall": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IPv4 to put on the firewall",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the firewall rules are applied",
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the firewall",
+			},
+		},
+	}
+}
+
+func resourceIpFirewallImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/IP formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	ip := splitId[2]
+	d.SetId(ip)
+	d.Set("ip", block)
+	d.Set("ip_on_firewall", ip)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_firewall").(string)
+
+	opts := &IpFirewallCreateOpts{IpOnFirewall: ip}
+	endpoint := fmt.Sprintf("/ip/%s/firewall", url.PathEscape(block))
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(ip)
+
+	if err := waitForIpFirewall(block, ip, config.OVHClient); err != nil {
+		return err
+	}
+
+	if d.Get("enabled").(bool) {
+		return resourceIpFirewallUpdate(d, meta)
+	}
+
+	return resourceIpFirewallRead(d, meta)
+}
+
+func resourceIpFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	firewall := &IpFirewall{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, firewall); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("ip_on_firewall", firewall.IpOnFirewall)
+	d.Set("enabled", firewall.Enabled)
+	d.Set("state", firewall.State)
+
+	return nil
+}
+
+func resourceIpFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	opts := &IpFirewallUpdateOpts{Enabled: d.Get("enabled").(bool)}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForIpFirewall(block, d.Id(), config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpFirewallRead(d, meta)
+}
+
+func resourceIpFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Id()
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ok", "enableFirewallPending", "disableFirewallPending", "removalPending"},
+		Target:     []string{"deleted"},
+		Refresh:    ipFirewallRefreshFunc(block, ip, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for firewall of %s to be deleted: %s", ip, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIpFirewall(block, ip string, c *ovh.Client) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"enableFirewallPending", "disableFirewallPending"},
+		Target:     []string{"ok"},
+		Refresh:    ipFirewallRefreshFunc(block, ip, c),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for firewall of %s to be ok: %s", ip, err)
+	}
+
+	return nil
+}
+
+func ipFirewallRefreshFunc(block, ip string, c *ovh.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		firewall := &IpFirewall{}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/firewall/%s",
+			url.PathEscape(block),
+			url.PathEscape(ip),
+		)
+
+		if err := c.Get(endpoint, firewall); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return firewall, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending firewall: %s", firewall)
+		return firewall, firewall.State, nil
+	}
+}
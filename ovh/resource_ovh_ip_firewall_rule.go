@@ -0,0 +1,313 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpFirewallRuleCreate,
+		Read:   resourceIpFirewallRuleRead,
+		Delete: resourceIpFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpFirewallRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the IP belongs to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_firewall": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IPv4 on the firewall",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"sequence": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Sequence number of the rule, from 0 to 19",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value < 0 || value > 19 {
+						errors = append(errors, fmt.Errorf("Value %d is not between 0 and 19", value))
+					}
+					return
+				},
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Action of the rule: permit or deny",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"deny", "permit"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Network protocol matched by the rule",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{
+						"ah",
+						"esp",
+						"gre",
+						"icmp",
+						"ipv4",
+						"tcp",
+						"udp",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Source IP block matched by the rule, any when not set",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"destination_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Destination port matched by the rule, tcp and udp only",
+			},
+			"source_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Source port matched by the rule, tcp and udp only",
+			},
+			"fragments": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Match fragmented packets only, tcp and udp only",
+			},
+			"tcp_option": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "TCP option matched by the rule: established or syn",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"established", "syn"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the rule",
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Destination IP of the rule",
+			},
+			"rule": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the rule",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the rule",
+			},
+		},
+	}
+}
+
+func resourceIpFirewallRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/IP/SEQUENCE formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	ip := splitId[2]
+	sequence, err := strconv.Atoi(splitId[3])
+	if err != nil {
+		return nil, fmt.Errorf("Sequence %s is not a number", splitId[3])
+	}
+	d.SetId(splitId[3])
+	d.Set("ip", block)
+	d.Set("ip_on_firewall", ip)
+	d.Set("sequence", sequence)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_firewall").(string)
+
+	opts := (&IpFirewallRuleCreateOpts{}).FromResource(d)
+	if opts.Protocol != "tcp" && opts.Protocol != "udp" &&
+		(opts.DestinationPort != nil || opts.SourcePort != nil || opts.Fragments != nil) {
+		return fmt.Errorf("Ports and fragments can only be matched by tcp and udp rules")
+	}
+	if opts.Protocol != "tcp" && opts.TcpOption != nil {
+		return fmt.Errorf("TCP options can only be matched by tcp rules")
+	}
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(strconv.Itoa(opts.Sequence))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creationPending"},
+		Target:     []string{"ok"},
+		Refresh:    ipFirewallRuleRefreshFunc(block, ip, opts.Sequence, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for firewall rule %d of %s to be created: %s", opts.Sequence, ip, err)
+	}
+
+	return resourceIpFirewallRuleRead(d, meta)
+}
+
+func resourceIpFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_firewall").(string)
+
+	rule := &IpFirewallRule{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule/%s",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, rule); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	for k, v := range rule.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceIpFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_firewall").(string)
+	sequence := d.Get("sequence").(int)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule/%d",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+		sequence,
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ok", "removalPending"},
+		Target:     []string{"deleted"},
+		Refresh:    ipFirewallRuleRefreshFunc(block, ip, sequence, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for firewall rule %d of %s to be deleted: %s", sequence, ip, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ipFirewallRuleRefreshFunc(block, ip string, sequence int, c *ovh.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule := &IpFirewallRule{}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/firewall/%s/rule/%d",
+			url.PathEscape(block),
+			url.PathEscape(ip),
+			sequence,
+		)
+
+		if err := c.Get(endpoint, rule); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return rule, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending firewall rule: %s", rule)
+		return rule, rule.State, nil
+	}
+}
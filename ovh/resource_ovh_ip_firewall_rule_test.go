@@ -0,0 +1,70 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpFirewallRule_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+	config := fmt.Sprintf(testAccIpFirewallRuleConfig, block, ip)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.ssh", "sequence", "0"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.ssh", "destination_port", "22"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.ssh", "source", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.ssh", "state", "ok"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.deny", "sequence", "19"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.deny", "state", "ok"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_firewall_rule.ssh",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/0", block, ip),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpFirewallRuleConfig = `
+resource "ovh_ip_firewall" "firewall" {
+  ip             = "%s"
+  ip_on_firewall = "%s"
+}
+
+resource "ovh_ip_firewall_rule" "ssh" {
+  ip               = ovh_ip_firewall.firewall.ip
+  ip_on_firewall   = ovh_ip_firewall.firewall.ip_on_firewall
+  sequence         = 0
+  action           = "permit"
+  protocol         = "tcp"
+  source           = "192.0.2.0/24"
+  destination_port = 22
+}
+
+resource "ovh_ip_firewall_rule" "deny" {
+  ip             = ovh_ip_firewall.firewall.ip
+  ip_on_firewall = ovh_ip_firewall.firewall.ip_on_firewall
+  sequence       = 19
+  action         = "deny"
+  protocol       = "ipv4"
+}
+`
@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpFirewall_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpFirewallConfig, block, ip, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall.firewall", "ip_on_firewall", ip),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall.firewall", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall.firewall", "state", "ok"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpFirewallConfig, block, ip, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall.firewall", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall.firewall", "state", "ok"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_firewall.firewall",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", block, ip),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpFirewallConfig = `
+resource "ovh_ip_firewall" "firewall" {
+  ip             = "%s"
+  ip_on_firewall = "%s"
+  enabled        = %s
+}
+`
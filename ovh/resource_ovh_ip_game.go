@@ -0,0 +1,180 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpGame() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpGameCreateOrUpdate,
+		Read:   resourceIpGameRead,
+		Update: resourceIpGameCreateOrUpdate,
+		Delete: resourceIpGameDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpGameImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the IP belongs to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_game": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IPv4 of the game server",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"firewall_mode_enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether traffic not matching a game rule is dropped",
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the game mitigation",
+			},
+		},
+	}
+}
+
+func resourceIpGameImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/IP formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	ip := splitId[2]
+	d.SetId(ip)
+	d.Set("ip", block)
+	d.Set("ip_on_game", ip)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpGameCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_game").(string)
+
+	if err := updateIpGameFirewallMode(block, ip, d.Get("firewall_mode_enabled").(bool), config); err != nil {
+		return err
+	}
+
+	d.SetId(ip)
+
+	return resourceIpGameRead(d, meta)
+}
+
+func resourceIpGameRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	game := &IpGame{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/game/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, game); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("ip_on_game", game.IpOnGame)
+	d.Set("firewall_mode_enabled", game.FirewallModeEnabled)
+	d.Set("state", game.State)
+
+	return nil
+}
+
+func resourceIpGameDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	// the game mitigation can't be removed, restore its default mode
+	if err := updateIpGameFirewallMode(block, d.Id(), false, config); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func updateIpGameFirewallMode(block, ip string, enabled bool, config *Config) error {
+	opts := &IpGameUpdateOpts{FirewallModeEnabled: enabled}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/game/%s",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"firewallModeDisablePending", "firewallModeEnablePending"},
+		Target:     []string{"ok"},
+		Refresh:    ipGameRefreshFunc(block, ip, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for game mitigation of %s to be ok: %s", ip, err)
+	}
+
+	return nil
+}
+
+func ipGameRefreshFunc(block, ip string, c *ovh.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		game := &IpGame{}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/game/%s",
+			url.PathEscape(block),
+			url.PathEscape(ip),
+		)
+
+		if err := c.Get(endpoint, game); err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending game mitigation: %s", game)
+		return game, game.State, nil
+	}
+}
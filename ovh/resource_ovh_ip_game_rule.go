@@ -0,0 +1,244 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpGameRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpGameRuleCreate,
+		Read:   resourceIpGameRuleRead,
+		Delete: resourceIpGameRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpGameRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the IP belongs to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_game": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IPv4 of the game server",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Game protocol of the rule",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{
+						"arkSurvivalEvolved",
+						"arma",
+						"gtaMultiTheftAutoSanAndreas",
+						"gtaSanAndreasMultiplayerMod",
+						"hl2Source",
+						"minecraftPocketEdition",
+						"minecraftQuery",
+						"mumble",
+						"other",
+						"rust",
+						"teamspeak2",
+						"teamspeak3",
+						"trackmaniaShootmania",
+					})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ports_from": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "First port of the range",
+			},
+			"ports_to": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Last port of the range, defaults to ports_from",
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the rule",
+			},
+		},
+	}
+}
+
+func resourceIpGameRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/IP/RULE_ID formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	ip := splitId[2]
+	d.SetId(splitId[3])
+	d.Set("ip", block)
+	d.Set("ip_on_game", ip)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpGameRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_game").(string)
+
+	opts := (&IpGameRuleCreateOpts{}).FromResource(d)
+	if opts.Ports.To < opts.Ports.From {
+		return fmt.Errorf("ports_to (%d) must be greater than ports_from (%d)", opts.Ports.To, opts.Ports.From)
+	}
+
+	rule := &IpGameRule{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/game/%s/rule",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, rule); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(strconv.FormatInt(rule.Id, 10))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"createRulePending"},
+		Target:     []string{"ok"},
+		Refresh:    ipGameRuleRefreshFunc(block, ip, rule.Id, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for game rule %d of %s to be created: %s", rule.Id, ip, err)
+	}
+
+	return resourceIpGameRuleRead(d, meta)
+}
+
+func resourceIpGameRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_game").(string)
+
+	rule := &IpGameRule{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/game/%s/rule/%s",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, rule); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("protocol", rule.Protocol)
+	d.Set("ports_from", rule.Ports.From)
+	d.Set("ports_to", rule.Ports.To)
+	d.Set("state", rule.State)
+
+	return nil
+}
+
+func resourceIpGameRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_game").(string)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Could not parse game rule id %s:\n\t %q", d.Id(), err)
+	}
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/game/%s/rule/%d",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+		id,
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ok", "deleteRulePending"},
+		Target:     []string{"deleted"},
+		Refresh:    ipGameRuleRefreshFunc(block, ip, id, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for game rule %d of %s to be deleted: %s", id, ip, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ipGameRuleRefreshFunc(block, ip string, id int64, c *ovh.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule := &IpGameRule{}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/game/%s/rule/%d",
+			url.PathEscape(block),
+			url.PathEscape(ip),
+			id,
+		)
+
+		if err := c.Get(endpoint, rule); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return rule, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending game rule: %s", rule)
+		return rule, rule.State, nil
+	}
+}
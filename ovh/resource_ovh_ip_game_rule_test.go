@@ -0,0 +1,81 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpGameRule_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_GAME_BLOCK")
+	ip := os.Getenv("OVH_IP_GAME")
+	config := fmt.Sprintf(testAccIpGameRuleConfig, block, ip)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpGame(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game_rule.minecraft", "protocol", "minecraftQuery"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game_rule.minecraft", "ports_from", "25565"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game_rule.minecraft", "ports_to", "25565"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game_rule.minecraft", "state", "ok"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game_rule.teamspeak", "ports_to", "9989"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_game_rule.teamspeak",
+				ImportState:       true,
+				ImportStateIdFunc: testAccIpGameRuleImportId("ovh_ip_game_rule.teamspeak"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIpGameRuleImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("game rule not found: %s", name)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			rs.Primary.Attributes["ip"],
+			rs.Primary.Attributes["ip_on_game"],
+			rs.Primary.ID,
+		), nil
+	}
+}
+
+const testAccIpGameRuleConfig = `
+locals {
+  block = "%s"
+  ip    = "%s"
+}
+
+resource "ovh_ip_game_rule" "minecraft" {
+  ip         = local.block
+  ip_on_game = local.ip
+  protocol   = "minecraftQuery"
+  ports_from = 25565
+}
+
+resource "ovh_ip_game_rule" "teamspeak" {
+  ip         = local.block
+  ip_on_game = local.ip
+  protocol   = "teamspeak3"
+  ports_from = 9987
+  ports_to   = 9989
+}
+`
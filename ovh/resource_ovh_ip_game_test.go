@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpGame_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_GAME_BLOCK")
+	ip := os.Getenv("OVH_IP_GAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpGame(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpGameConfig, block, ip, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game.game", "firewall_mode_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game.game", "state", "ok"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpGameConfig, block, ip, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_game.game", "firewall_mode_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_game.game",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", block, ip),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpGameConfig = `
+resource "ovh_ip_game" "game" {
+  ip                    = "%s"
+  ip_on_game            = "%s"
+  firewall_mode_enabled = %s
+}
+`
@@ -0,0 +1,236 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpMitigation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpMitigationCreate,
+		Read:   resourceIpMitigationRead,
+		Update: resourceIpMitigationUpdate,
+		Delete: resourceIpMitigationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpMitigationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the IP belongs to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_mitigation": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IPv4 to put under mitigation",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"permanent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the mitigation is permanent",
+			},
+
+			// Computed
+			"auto": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the mitigation has been triggered automatically by OVH",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the mitigation",
+			},
+		},
+	}
+}
+
+func resourceIpMitigationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/IP formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	ip := splitId[2]
+	d.SetId(ip)
+	d.Set("ip", block)
+	d.Set("ip_on_mitigation", ip)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpMitigationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Get("ip_on_mitigation").(string)
+
+	opts := &IpMitigationCreateOpts{IpOnMitigation: ip}
+	endpoint := fmt.Sprintf("/ip/%s/mitigation", url.PathEscape(block))
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(ip)
+
+	if err := waitForIpMitigation(block, ip, config.OVHClient); err != nil {
+		return err
+	}
+
+	// a newly created mitigation is permanent
+	if !d.Get("permanent").(bool) {
+		return resourceIpMitigationUpdate(d, meta)
+	}
+
+	return resourceIpMitigationRead(d, meta)
+}
+
+func resourceIpMitigationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	mitigation := &IpMitigation{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, mitigation); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	if mitigation.Auto {
+		log.Printf("[WARN] Mitigation of %s has been triggered automatically by OVH", mitigation.IpOnMitigation)
+	}
+
+	d.Set("ip_on_mitigation", mitigation.IpOnMitigation)
+	d.Set("permanent", mitigation.Permanent)
+	d.Set("auto", mitigation.Auto)
+	d.Set("state", mitigation.State)
+
+	return nil
+}
+
+func resourceIpMitigationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	opts := &IpMitigationUpdateOpts{Permanent: d.Get("permanent").(bool)}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForIpMitigation(block, d.Id(), config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpMitigationRead(d, meta)
+}
+
+func resourceIpMitigationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	ip := d.Id()
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ok", "removalPending"},
+		Target:     []string{"deleted"},
+		Refresh:    ipMitigationRefreshFunc(block, ip, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for mitigation of %s to be deleted: %s", ip, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIpMitigation(block, ip string, c *ovh.Client) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creationPending"},
+		Target:     []string{"ok"},
+		Refresh:    ipMitigationRefreshFunc(block, ip, c),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for mitigation of %s to be ok: %s", ip, err)
+	}
+
+	return nil
+}
+
+func ipMitigationRefreshFunc(block, ip string, c *ovh.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		mitigation := &IpMitigation{}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/mitigation/%s",
+			url.PathEscape(block),
+			url.PathEscape(ip),
+		)
+
+		if err := c.Get(endpoint, mitigation); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return mitigation, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending mitigation: %s", mitigation)
+		return mitigation, mitigation.State, nil
+	}
+}
@@ -0,0 +1,234 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpMitigationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpMitigationProfileCreate,
+		Read:   resourceIpMitigationProfileRead,
+		Update: resourceIpMitigationProfileUpdate,
+		Delete: resourceIpMitigationProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpMitigationProfileImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the profile applies to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_mitigation_profile": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP or IP block of the profile",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"auto_mitigation_timeout": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Delay in minutes before an automatic mitigation is stopped once the attack is over",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(int) {
+					case 0, 15, 60, 360, 1560:
+					default:
+						errors = append(errors, fmt.Errorf("Value %d is not among valid values (0, 15, 60, 360, 1560)", v.(int)))
+					}
+					return
+				},
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the profile",
+			},
+		},
+	}
+}
+
+func resourceIpMitigationProfileImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.Split(givenId, "/")
+	if len(splitId) != 4 {
+		return nil, fmt.Errorf("Import Id is not BLOCK/PROFILE formatted")
+	}
+	block := strings.Join(splitId[0:2], "/")
+	profile := strings.Join(splitId[2:4], "/")
+	d.SetId(profile)
+	d.Set("ip", block)
+	d.Set("ip_mitigation_profile", profile)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpMitigationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	opts := &IpMitigationProfileCreateOpts{
+		AutoMitigationTimeOut: d.Get("auto_mitigation_timeout").(int),
+		IpMitigationProfile:   d.Get("ip_mitigation_profile").(string),
+	}
+	endpoint := fmt.Sprintf("/ip/%s/mitigationProfiles", url.PathEscape(block))
+
+	profile := &IpMitigationProfile{}
+	if err := config.OVHClient.Post(endpoint, opts, profile); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	// the API normalizes the profile ip as a block
+	d.SetId(profile.IpMitigationProfile)
+
+	if err := waitForIpMitigationProfile(block, d.Id(), config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpMitigationProfileRead(d, meta)
+}
+
+func resourceIpMitigationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	profile := &IpMitigationProfile{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Get(endpoint, profile); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("ip_mitigation_profile", profile.IpMitigationProfile)
+	d.Set("auto_mitigation_timeout", profile.AutoMitigationTimeOut)
+	d.Set("state", profile.State)
+
+	return nil
+}
+
+func resourceIpMitigationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	opts := &IpMitigationProfileUpdateOpts{
+		AutoMitigationTimeOut: d.Get("auto_mitigation_timeout").(int),
+	}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(block),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForIpMitigationProfile(block, d.Id(), config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpMitigationProfileRead(d, meta)
+}
+
+func resourceIpMitigationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	profile := d.Id()
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(block),
+		url.PathEscape(profile),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ok", "tasksPending"},
+		Target:     []string{"deleted"},
+		Refresh:    ipMitigationProfileRefreshFunc(block, profile, config.OVHClient),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for mitigation profile %s to be deleted: %s", profile, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIpMitigationProfile(block, profile string, c *ovh.Client) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"tasksPending"},
+		Target:     []string{"ok"},
+		Refresh:    ipMitigationProfileRefreshFunc(block, profile, c),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for mitigation profile %s to be ok: %s", profile, err)
+	}
+
+	return nil
+}
+
+func ipMitigationProfileRefreshFunc(block, profile string, c *ovh.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		p := &IpMitigationProfile{}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/mitigationProfiles/%s",
+			url.PathEscape(block),
+			url.PathEscape(profile),
+		)
+
+		if err := c.Get(endpoint, p); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return p, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Pending mitigation profile: %s", p)
+		return p, p.State, nil
+	}
+}
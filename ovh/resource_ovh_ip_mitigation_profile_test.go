@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpMitigationProfile_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	profile := fmt.Sprintf("%s/32", os.Getenv("OVH_IP"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpMitigationProfileConfig, block, profile, 15),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation_profile.profile", "ip_mitigation_profile", profile),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation_profile.profile", "auto_mitigation_timeout", "15"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation_profile.profile", "state", "ok"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpMitigationProfileConfig, block, profile, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation_profile.profile", "auto_mitigation_timeout", "60"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_mitigation_profile.profile",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", block, profile),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpMitigationProfileConfig = `
+resource "ovh_ip_mitigation_profile" "profile" {
+  ip                      = "%s"
+  ip_mitigation_profile   = "%s"
+  auto_mitigation_timeout = %d
+}
+`
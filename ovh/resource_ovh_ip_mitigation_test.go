@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpMitigation_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpMitigationConfig, block, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "ip_on_mitigation", ip),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "permanent", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "state", "ok"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_ip_mitigation.mitigation", "auto"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_mitigation.mitigation",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", block, ip),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpMitigationConfig = `
+resource "ovh_ip_mitigation" "mitigation" {
+  ip               = "%s"
+  ip_on_mitigation = "%s"
+}
+`
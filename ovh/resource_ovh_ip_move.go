@@ -0,0 +1,281 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpMove() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpMoveCreateOrUpdate,
+		Read:   resourceIpMoveRead,
+		Update: resourceIpMoveCreateOrUpdate,
+		Delete: resourceIpMoveDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("ip", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: resourceIpMoveCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block to move",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"routed_to": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Service the IP block is routed to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the service the IP block is routed to",
+						},
+					},
+				},
+			},
+			"nexthop": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Nexthop of the destination, for services which require one",
+			},
+
+			// Computed
+			"can_be_terminated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organisation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the IP block",
+			},
+			"destinations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Services the IP block can be moved to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the service",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the service",
+						},
+						"nexthop": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Nexthop to use with the service",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIpMoveCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("routed_to") ||
+		!d.NewValueKnown("ip") ||
+		!d.NewValueKnown("routed_to.0.service_name") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	serviceName := d.Get("routed_to.0.service_name").(string)
+
+	ip, err := getIp(block, config)
+	if err != nil {
+		return err
+	}
+	if ip.RoutedTo.ServiceName != nil && *ip.RoutedTo.ServiceName == serviceName {
+		return nil
+	}
+
+	destinations, err := getIpMoveDestinations(block, config)
+	if err != nil {
+		return err
+	}
+
+	services := []string{}
+	for _, destination := range ipMoveDestinationsToList(destinations) {
+		if destination["service"] == serviceName {
+			return nil
+		}
+		services = append(services, destination["service"].(string))
+	}
+
+	return fmt.Errorf("IP %s can't be moved to %s, available destinations: %v", block, serviceName, services)
+}
+
+func resourceIpMoveCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	serviceName := d.Get("routed_to.0.service_name").(string)
+
+	ip, err := getIp(block, config)
+	if err != nil {
+		return err
+	}
+
+	// a nexthop change is applied by moving the IP again to the same service
+	nexthopChanged := d.Id() != "" && d.HasChange("nexthop")
+
+	d.SetId(block)
+
+	if ip.RoutedTo.ServiceName != nil && *ip.RoutedTo.ServiceName == serviceName && !nexthopChanged {
+		log.Printf("[INFO] IP %s is already routed to %s", block, serviceName)
+		return resourceIpMoveRead(d, meta)
+	}
+
+	opts := &IpMoveOpts{
+		To:      serviceName,
+		Nexthop: helpers.GetNilStringPointerFromData(d, "nexthop"),
+	}
+	task := &IpTask{}
+	endpoint := fmt.Sprintf("/ip/%s/move", url.PathEscape(block))
+
+	log.Printf("[INFO] Moving IP %s to %s", block, serviceName)
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForIpTask(block, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpMoveRead(d, meta)
+}
+
+func resourceIpMoveRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Id()
+
+	ip := &Ip{}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(block))
+	if err := config.OVHClient.Get(endpoint, ip); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	destinations, err := getIpMoveDestinations(block, config)
+	if err != nil {
+		return err
+	}
+
+	routedTo := []map[string]interface{}{}
+	if ip.RoutedTo.ServiceName != nil {
+		routedTo = append(routedTo, map[string]interface{}{
+			"service_name": *ip.RoutedTo.ServiceName,
+		})
+	}
+
+	d.Set("ip", ip.Ip)
+	d.Set("routed_to", routedTo)
+	d.Set("can_be_terminated", ip.CanBeTerminated)
+	d.Set("type", ip.Type)
+	d.Set("destinations", ipMoveDestinationsToList(destinations))
+
+	if ip.Country != nil {
+		d.Set("country", *ip.Country)
+	}
+	if ip.Description != nil {
+		d.Set("description", *ip.Description)
+	}
+	if ip.OrganisationId != nil {
+		d.Set("organisation_id", *ip.OrganisationId)
+	}
+
+	return nil
+}
+
+func resourceIpMoveDelete(d *schema.ResourceData, meta interface{}) error {
+	// moving the IP elsewhere or parking it would break the service
+	// it is routed to, just forget about it
+	log.Printf("[WARN] IP %s is left routed to its current service on destroy", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func getIp(block string, config *Config) (*Ip, error) {
+	ip := &Ip{}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(block))
+
+	if err := config.OVHClient.Get(endpoint, ip); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return ip, nil
+}
+
+func getIpMoveDestinations(block string, config *Config) (IpDestinations, error) {
+	destinations := IpDestinations{}
+	endpoint := fmt.Sprintf("/ip/%s/move", url.PathEscape(block))
+
+	if err := config.OVHClient.Get(endpoint, &destinations); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return destinations, nil
+}
+
+func ipMoveDestinationsToList(destinations IpDestinations) []map[string]interface{} {
+	types := make([]string, 0, len(destinations))
+	for t := range destinations {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	result := []map[string]interface{}{}
+	for _, t := range types {
+		for _, destination := range destinations[t] {
+			obj := map[string]interface{}{
+				"type":    t,
+				"service": destination.Service,
+			}
+			if destination.Nexthop != nil {
+				obj["nexthop"] = *destination.Nexthop
+			}
+			result = append(result, obj)
+		}
+	}
+
+	return result
+}
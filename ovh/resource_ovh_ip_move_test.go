@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpMove_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_MOVE_BLOCK")
+	serviceName1 := os.Getenv("OVH_IP_MOVE_SERVICE_NAME_1")
+	serviceName2 := os.Getenv("OVH_IP_MOVE_SERVICE_NAME_2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpMove(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpMoveConfig, block, serviceName1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_move.move", "routed_to.0.service_name", serviceName1),
+					resource.TestCheckResourceAttrSet(
+						"ovh_ip_move.move", "type"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpMoveConfig, block, serviceName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_move.move", "routed_to.0.service_name", serviceName2),
+					resource.TestCheckResourceAttrSet(
+						"ovh_ip_move.move", "destinations.#"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccIpMoveConfig, block, "ns0000000.ip-192-0-2.eu"),
+				ExpectError: regexp.MustCompile("can't be moved to"),
+			},
+		},
+	})
+}
+
+const testAccIpMoveConfig = `
+resource "ovh_ip_move" "move" {
+  ip = "%s"
+
+  routed_to {
+    service_name = "%s"
+  }
+}
+`
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net"
-	"strings"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
@@ -86,7 +86,7 @@ func resourceOvhIpReverseCreate(d *schema.ResourceData, meta interface{}) error
 	resultReverse := OvhIpReverse{}
 
 	err := provider.OVHClient.Post(
-		fmt.Sprintf("/ip/%s/reverse", strings.Replace(newIp, "/", "%2F", 1)),
+		fmt.Sprintf("/ip/%s/reverse", url.PathEscape(newIp)),
 		newReverse,
 		&resultReverse,
 	)
@@ -105,7 +105,7 @@ func resourceOvhIpReverseRead(d *schema.ResourceData, meta interface{}) error {
 	reverse := OvhIpReverse{}
 	endpoint := fmt.Sprintf(
 		"/ip/%s/reverse/%s",
-		strings.Replace(d.Get("ip").(string), "/", "%2F", 1),
+		url.PathEscape(d.Get("ip").(string)),
 		d.Get("ipreverse").(string),
 	)
 
@@ -134,7 +134,7 @@ func resourceOvhIpReverseUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] OVH IP Reverse update configuration: %#v", reverse)
 
 	err := provider.OVHClient.Post(
-		fmt.Sprintf("/ip/%s/reverse", strings.Replace(d.Get("ip").(string), "/", "%2F", 1)),
+		fmt.Sprintf("/ip/%s/reverse", url.PathEscape(d.Get("ip").(string))),
 		reverse,
 		nil,
 	)
@@ -151,7 +151,7 @@ func resourceOvhIpReverseDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Deleting OVH IP Reverse: %s->%s", d.Get("reverse").(string), d.Get("ipreverse").(string))
 
 	err := provider.OVHClient.Delete(
-		fmt.Sprintf("/ip/%s/reverse/%s", strings.Replace(d.Get("ip").(string), "/", "%2F", 1), d.Get("ipreverse").(string)),
+		fmt.Sprintf("/ip/%s/reverse/%s", url.PathEscape(d.Get("ip").(string)), d.Get("ipreverse").(string)),
 		nil,
 	)
 
@@ -164,7 +164,7 @@ func resourceOvhIpReverseDelete(d *schema.ResourceData, meta interface{}) error
 
 func resourceOvhIpReverseExists(ip, ipreverse string, c *ovh.Client) error {
 	reverse := OvhIpReverse{}
-	endpoint := fmt.Sprintf("/ip/%s/reverse/%s", strings.Replace(ip, "/", "%2F", 1), ipreverse)
+	endpoint := fmt.Sprintf("/ip/%s/reverse/%s", url.PathEscape(ip), ipreverse)
 
 	err := c.Get(endpoint, &reverse)
 	if err != nil {
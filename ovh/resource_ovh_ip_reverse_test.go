@@ -3,8 +3,8 @@ package ovh
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"os"
-	"strings"
 	"testing"
 	"time"
 
@@ -38,7 +38,7 @@ func testSweepIpReverse(region string) error {
 	reverse := OvhIpReverse{}
 	testIp := os.Getenv("OVH_IP_BLOCK")
 	testIpReverse := os.Getenv("OVH_IP")
-	endpoint := fmt.Sprintf("/ip/%s/reverse/%s", strings.Replace(testIp, "/", "%2F", 1), testIpReverse)
+	endpoint := fmt.Sprintf("/ip/%s/reverse/%s", url.PathEscape(testIp), testIpReverse)
 	if err := client.Get(endpoint, &reverse); err != nil {
 		if err.(*ovh.APIError).Code == 404 {
 			// no ip reverse set, nothing to sweep
@@ -0,0 +1,366 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+// maximum number of addresses a reverse template can be expanded to
+// when no explicit list of ips is given
+const ipReversesTemplateMaxAddresses = 256
+
+func resourceIpReverses() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpReversesCreateOrUpdate,
+		Read:   resourceIpReversesRead,
+		Update: resourceIpReversesCreateOrUpdate,
+		Delete: resourceIpReversesDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("ip", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: resourceIpReversesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The IP block the reverses belong to",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"reverses": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Map of IP to reverse. Every other reverse of the block is removed",
+				ConflictsWith: []string{"reverse_template"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"reverse_template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Reverse template applied to every IP, supports {ip} and {ip_dashed} placeholders",
+				ConflictsWith: []string{"reverses"},
+			},
+			"reverse_template_ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IPs the reverse template is applied to. Defaults to every address of the block",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := helpers.ValidateIp(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: schema.HashString,
+			},
+			"verify_forward_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OVH zone holding the forward records of the reverses. When set, every reverse must resolve back to its IP in this zone before being applied",
+			},
+		},
+	}
+}
+
+func resourceIpReversesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("ip") ||
+		!d.NewValueKnown("reverses") ||
+		!d.NewValueKnown("reverse_template") ||
+		!d.NewValueKnown("reverse_template_ips") {
+		return nil
+	}
+
+	_, block, err := net.ParseCIDR(d.Get("ip").(string))
+	if err != nil {
+		return err
+	}
+
+	template := d.Get("reverse_template").(string)
+	if template == "" {
+		reverses := d.Get("reverses").(map[string]interface{})
+		for ip := range reverses {
+			if err := ipReversesCheckIp(block, ip); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ips := []string{}
+	if v, ok := d.GetOk("reverse_template_ips"); ok {
+		for _, ip := range v.(*schema.Set).List() {
+			if err := ipReversesCheckIp(block, ip.(string)); err != nil {
+				return err
+			}
+			ips = append(ips, ip.(string))
+		}
+	} else {
+		ips, err = ipReversesBlockAddresses(block)
+		if err != nil {
+			return err
+		}
+	}
+
+	reverses := make(map[string]interface{})
+	for _, ip := range ips {
+		reverses[ip] = ipReversesExpandTemplate(template, ip)
+	}
+
+	return d.SetNew("reverses", reverses)
+}
+
+func resourceIpReversesCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	desired := d.Get("reverses").(map[string]interface{})
+	current, err := ipReversesFetch(block, config)
+	if err != nil {
+		return err
+	}
+
+	toSet := []string{}
+	for ip, reverse := range desired {
+		if r, ok := current[ip]; !ok || !ipReversesEqual(r, reverse.(string)) {
+			toSet = append(toSet, ip)
+		}
+	}
+	sort.Strings(toSet)
+
+	toDelete := []string{}
+	for ip := range current {
+		if _, ok := desired[ip]; !ok {
+			toDelete = append(toDelete, ip)
+		}
+	}
+	sort.Strings(toDelete)
+
+	if zone, ok := d.GetOk("verify_forward_zone"); ok {
+		for _, ip := range toSet {
+			if err := ipReversesVerifyForward(zone.(string), ip, desired[ip].(string), config); err != nil {
+				return err
+			}
+		}
+	}
+
+	endpoint := fmt.Sprintf("/ip/%s/reverse", url.PathEscape(block))
+
+	for _, ip := range toSet {
+		opts := &OvhIpReverse{
+			IpReverse: ip,
+			Reverse:   desired[ip].(string),
+		}
+
+		log.Printf("[DEBUG] Will set reverse of %s to %s", ip, opts.Reverse)
+		if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	for _, ip := range toDelete {
+		if err := ipReversesDelete(block, ip, config); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(block)
+
+	return resourceIpReversesRead(d, meta)
+}
+
+func resourceIpReversesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Id()
+
+	current, err := ipReversesFetch(block, config)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, fmt.Sprintf("/ip/%s/reverse", url.PathEscape(block)))
+	}
+
+	// keep the configured notation of reverses which only differ
+	// by the trailing dot the API appends
+	previous := d.Get("reverses").(map[string]interface{})
+	reverses := make(map[string]interface{})
+	for ip, reverse := range current {
+		if p, ok := previous[ip]; ok && ipReversesEqual(p.(string), reverse) {
+			reverse = p.(string)
+		}
+		reverses[ip] = reverse
+	}
+
+	d.Set("ip", block)
+	d.Set("reverses", reverses)
+
+	return nil
+}
+
+func resourceIpReversesDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Id()
+
+	reverses := d.Get("reverses").(map[string]interface{})
+	for ip := range reverses {
+		if err := ipReversesDelete(block, ip, config); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// ipReversesFetch returns the reverses of the block indexed by ip
+func ipReversesFetch(block string, config *Config) (map[string]string, error) {
+	endpoint := fmt.Sprintf("/ip/%s/reverse", url.PathEscape(block))
+
+	ips := []string{}
+	if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+		return nil, err
+	}
+
+	reverses := make([]OvhIpReverse, len(ips))
+	err := helpers.ParallelFor(len(ips), 8, func(i int) error {
+		reverseEndpoint := fmt.Sprintf("%s/%s", endpoint, url.PathEscape(ips[i]))
+		if err := config.OVHClient.Get(reverseEndpoint, &reverses[i]); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", reverseEndpoint, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, reverse := range reverses {
+		result[reverse.IpReverse] = reverse.Reverse
+	}
+
+	return result, nil
+}
+
+func ipReversesDelete(block, ip string, config *Config) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/reverse/%s",
+		url.PathEscape(block),
+		url.PathEscape(ip),
+	)
+
+	log.Printf("[INFO] Deleting reverse of %s", ip)
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	return nil
+}
+
+// ipReversesVerifyForward checks the reverse of ip resolves back
+// to ip through an A or AAAA record of zone
+func ipReversesVerifyForward(zone, ip, reverse string, config *Config) error {
+	host := strings.TrimSuffix(reverse, ".")
+	if host != zone && !strings.HasSuffix(host, "."+zone) {
+		return fmt.Errorf("Reverse %s of %s is not part of zone %s", reverse, ip, zone)
+	}
+	subdomain := strings.TrimSuffix(strings.TrimSuffix(host, zone), ".")
+
+	fieldType := "AAAA"
+	if net.ParseIP(ip).To4() != nil {
+		fieldType = "A"
+	}
+
+	record, err := ovhDomainZoneRecordLookup(config.OVHClient, zone, subdomain, fieldType, ip)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return fmt.Errorf(
+			"Forward confirmed DNS check failed: no %s record %s pointing to %s in zone %s",
+			fieldType,
+			host,
+			ip,
+			zone,
+		)
+	}
+
+	return nil
+}
+
+// ipReversesCheckIp checks ip is in its canonical form and belongs to block
+func ipReversesCheckIp(block *net.IPNet, ip string) error {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return fmt.Errorf("%s is not a valid IP", ip)
+	}
+	if parsed.String() != ip {
+		return fmt.Errorf("IP %s must be written in its canonical form %s", ip, parsed.String())
+	}
+	if !block.Contains(parsed) {
+		return fmt.Errorf("IP %s is not part of block %s", ip, block)
+	}
+	return nil
+}
+
+// ipReversesBlockAddresses lists the addresses of block, leaving out
+// the network and broadcast addresses of IPv4 blocks
+func ipReversesBlockAddresses(block *net.IPNet) ([]string, error) {
+	ones, bits := block.Mask.Size()
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	if size.Cmp(big.NewInt(ipReversesTemplateMaxAddresses)) > 0 {
+		return nil, fmt.Errorf(
+			"Block %s has more than %d addresses, reverse_template_ips must be set",
+			block,
+			ipReversesTemplateMaxAddresses,
+		)
+	}
+
+	count := int(size.Int64())
+	first, last := 0, count
+	if block.IP.To4() != nil && bits-ones > 1 {
+		first, last = 1, count-1
+	}
+
+	base := new(big.Int).SetBytes(block.IP)
+	ips := []string{}
+	for i := first; i < last; i++ {
+		value := new(big.Int).Add(base, big.NewInt(int64(i))).Bytes()
+		ip := make(net.IP, len(block.IP))
+		copy(ip[len(ip)-len(value):], value)
+		ips = append(ips, ip.String())
+	}
+
+	return ips, nil
+}
+
+func ipReversesExpandTemplate(template, ip string) string {
+	dashed := strings.NewReplacer(".", "-", ":", "-").Replace(ip)
+	return strings.NewReplacer("{ip}", ip, "{ip_dashed}", dashed).Replace(template)
+}
+
+func ipReversesEqual(a, b string) bool {
+	return strings.TrimSuffix(a, ".") == strings.TrimSuffix(b, ".")
+}
@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpReverses_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+	reverse := os.Getenv("OVH_IP_REVERSE")
+	config := fmt.Sprintf(testAccIpReversesConfig, block, ip, reverse)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_reverses.reverses", "ip", block),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_reverses.reverses", "reverses.%", "1"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_reverses.reverses", fmt.Sprintf("reverses.%s", ip), reverse),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_reverses.reverses",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIpReversesBlockAddresses(t *testing.T) {
+	cases := map[string][]string{
+		"192.0.2.0/30":       {"192.0.2.1", "192.0.2.2"},
+		"192.0.2.8/31":       {"192.0.2.8", "192.0.2.9"},
+		"192.0.2.42/32":      {"192.0.2.42"},
+		"2001:db8::fffe/127": {"2001:db8::fffe", "2001:db8::ffff"},
+	}
+
+	for cidr, expected := range cases {
+		_, block, _ := net.ParseCIDR(cidr)
+		ips, err := ipReversesBlockAddresses(block)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %s", cidr, err)
+		}
+		if !reflect.DeepEqual(ips, expected) {
+			t.Fatalf("%s: expected %v, got %v", cidr, expected, ips)
+		}
+	}
+
+	_, block, _ := net.ParseCIDR("2001:db8::/64")
+	if _, err := ipReversesBlockAddresses(block); err == nil {
+		t.Fatalf("expected an error on a /64")
+	}
+}
+
+func TestIpReversesExpandTemplate(t *testing.T) {
+	if v := ipReversesExpandTemplate("host-{ip_dashed}.example.net", "192.0.2.1"); v != "host-192-0-2-1.example.net" {
+		t.Fatalf("unexpected IPv4 expansion %s", v)
+	}
+	if v := ipReversesExpandTemplate("host-{ip_dashed}.example.net", "2001:db8::1"); v != "host-2001-db8--1.example.net" {
+		t.Fatalf("unexpected IPv6 expansion %s", v)
+	}
+}
+
+const testAccIpReversesConfig = `
+resource "ovh_ip_reverses" "reverses" {
+  ip = "%s"
+
+  reverses = {
+    "%s" = "%s"
+  }
+}
+`
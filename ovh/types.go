@@ -34,3 +34,29 @@ func (v UnitAndValue) ToMap() map[string]interface{} {
 
 	return obj
 }
+
+type ServiceInfosRenew struct {
+	Automatic          bool `json:"automatic"`
+	DeleteAtExpiration bool `json:"deleteAtExpiration"`
+	Forced             bool `json:"forced"`
+	ManualPayment      bool `json:"manualPayment"`
+	Period             *int `json:"period,omitempty"`
+}
+
+type ServiceInfos struct {
+	ContactAdmin   string             `json:"contactAdmin"`
+	ContactBilling string             `json:"contactBilling"`
+	ContactTech    string             `json:"contactTech"`
+	Creation       string             `json:"creation"`
+	Domain         string             `json:"domain"`
+	EngagedUpTo    *string            `json:"engagedUpTo"`
+	Expiration     string             `json:"expiration"`
+	Renew          *ServiceInfosRenew `json:"renew"`
+	RenewalType    string             `json:"renewalType"`
+	ServiceId      int64              `json:"serviceId"`
+	Status         string             `json:"status"`
+}
+
+type ServiceInfosUpdateOpts struct {
+	Renew *ServiceInfosRenew `json:"renew"`
+}
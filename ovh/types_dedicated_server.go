@@ -27,6 +27,27 @@ type DedicatedServer struct {
 	SupportLevel    string `json:"supportLevel"`
 }
 
+func (v DedicatedServer) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["boot_id"] = v.BootId
+	obj["commercial_range"] = v.CommercialRange
+	obj["datacenter"] = v.Datacenter
+	obj["ip"] = v.Ip
+	obj["link_speed"] = v.LinkSpeed
+	obj["monitoring"] = v.Monitoring
+	obj["name"] = v.Name
+	obj["os"] = v.Os
+	obj["professional_use"] = v.ProfessionalUse
+	obj["rack"] = v.Rack
+	obj["rescue_mail"] = v.RescueMail
+	obj["reverse"] = v.Reverse
+	obj["root_device"] = v.RootDevice
+	obj["server_id"] = v.ServerId
+	obj["state"] = v.State
+	obj["support_level"] = v.SupportLevel
+	return obj
+}
+
 func (ds DedicatedServer) String() string {
 	return fmt.Sprintf(
 		"name: %v, ip: %v, dc: %v, state: %v",
@@ -50,6 +71,12 @@ func (opts *DedicatedServerUpdateOpts) FromResource(d *schema.ResourceData) *Ded
 	return opts
 }
 
+type DedicatedServerRescueOpts struct {
+	BootId       int64   `json:"bootId"`
+	RescueMail   *string `json:"rescueMail,omitempty"`
+	RescueSshKey *string `json:"rescueSshKey,omitempty"`
+}
+
 type DedicatedServerVNI struct {
 	Enabled    bool     `json:"enabled"`
 	Mode       string   `json:"mode"`
@@ -86,6 +113,15 @@ func (v DedicatedServerVNI) ToMap() map[string]interface{} {
 	return obj
 }
 
+type DedicatedServerOlaAggregationOpts struct {
+	Name                     string   `json:"name"`
+	VirtualNetworkInterfaces []string `json:"virtualNetworkInterfaces"`
+}
+
+type DedicatedServerOlaResetOpts struct {
+	VirtualNetworkInterface string `json:"virtualNetworkInterface"`
+}
+
 type DedicatedServerBoot struct {
 	BootId      int    `json:"bootId"`
 	BootType    string `json:"bootType"`
@@ -103,6 +139,25 @@ type DedicatedServerTask struct {
 	StartDate  time.Time `json:"startDate"`
 }
 
+type DedicatedServerInstallProgressStep struct {
+	Comment string `json:"comment"`
+	Error   string `json:"error"`
+	Status  string `json:"status"`
+}
+
+func (v DedicatedServerInstallProgressStep) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["comment"] = v.Comment
+	obj["error"] = v.Error
+	obj["status"] = v.Status
+	return obj
+}
+
+type DedicatedServerInstallStatus struct {
+	ElapsedTime int64                                `json:"elapsedTime"`
+	Progress    []DedicatedServerInstallProgressStep `json:"progress"`
+}
+
 type DedicatedServerInstallTaskCreateOpts struct {
 	TemplateName        string                             `json:"templateName"`
 	PartitionSchemeName *string                            `json:"partitionSchemeName,omitempty"`
@@ -154,3 +209,468 @@ func (opts *DedicatedServerInstallTaskDetails) FromResource(d *schema.ResourceDa
 
 	return opts
 }
+
+type DedicatedServerVirtualMac struct {
+	MacAddress string `json:"macAddress"`
+	Type       string `json:"type"`
+}
+
+type DedicatedServerVirtualMacAddress struct {
+	IpAddress          string `json:"ipAddress"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+type DedicatedServerVirtualMacCreateOpts struct {
+	IpAddress          string `json:"ipAddress"`
+	Type               string `json:"type"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+func (opts *DedicatedServerVirtualMacCreateOpts) FromResource(d *schema.ResourceData) *DedicatedServerVirtualMacCreateOpts {
+	opts.IpAddress = d.Get("ip_address").(string)
+	opts.Type = "ovh"
+	if v, ok := d.GetOk("type"); ok {
+		opts.Type = v.(string)
+	}
+	opts.VirtualMachineName = d.Get("virtual_machine_name").(string)
+	return opts
+}
+
+type DedicatedServerVirtualMacAddressCreateOpts struct {
+	IpAddress          string `json:"ipAddress"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+func (opts *DedicatedServerVirtualMacAddressCreateOpts) FromResource(d *schema.ResourceData) *DedicatedServerVirtualMacAddressCreateOpts {
+	opts.IpAddress = d.Get("ip_address").(string)
+	opts.VirtualMachineName = d.Get("virtual_machine_name").(string)
+	return opts
+}
+
+// sizes and bandwidths of the specifications may not be integers
+type DedicatedServerSpecificationValue struct {
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+func (v *DedicatedServerSpecificationValue) ToList() []map[string]interface{} {
+	if v == nil {
+		return []map[string]interface{}{}
+	}
+
+	obj := make(map[string]interface{})
+	obj["unit"] = v.Unit
+	obj["value"] = v.Value
+	return []map[string]interface{}{obj}
+}
+
+type DedicatedServerDiskGroup struct {
+	DefaultHardwareRaidSize *DedicatedServerSpecificationValue `json:"defaultHardwareRaidSize"`
+	DefaultHardwareRaidType *string                            `json:"defaultHardwareRaidType"`
+	Description             string                             `json:"description"`
+	DiskGroupId             int64                              `json:"diskGroupId"`
+	DiskSize                *DedicatedServerSpecificationValue `json:"diskSize"`
+	DiskType                string                             `json:"diskType"`
+	NumberOfDisks           int64                              `json:"numberOfDisks"`
+	RaidController          *string                            `json:"raidController"`
+}
+
+func (v DedicatedServerDiskGroup) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["default_hardware_raid_size"] = v.DefaultHardwareRaidSize.ToList()
+	obj["description"] = v.Description
+	obj["disk_group_id"] = v.DiskGroupId
+	obj["disk_size"] = v.DiskSize.ToList()
+	obj["disk_type"] = v.DiskType
+	obj["number_of_disks"] = v.NumberOfDisks
+
+	if v.DefaultHardwareRaidType != nil {
+		obj["default_hardware_raid_type"] = *v.DefaultHardwareRaidType
+	}
+	if v.RaidController != nil {
+		obj["raid_controller"] = *v.RaidController
+	}
+
+	return obj
+}
+
+type DedicatedServerHardwareSpecifications struct {
+	BootMode              string                             `json:"bootMode"`
+	CoresPerProcessor     *int64                             `json:"coresPerProcessor"`
+	CpuFamily             string                             `json:"cpuFamily"`
+	Description           *string                            `json:"description"`
+	DiskGroups            []DedicatedServerDiskGroup         `json:"diskGroups"`
+	FormFactor            *string                            `json:"formFactor"`
+	MemorySize            *DedicatedServerSpecificationValue `json:"memorySize"`
+	Motherboard           *string                            `json:"motherboard"`
+	NumberOfProcessors    *int64                             `json:"numberOfProcessors"`
+	ProcessorArchitecture string                             `json:"processorArchitecture"`
+	ProcessorName         *string                            `json:"processorName"`
+	ThreadsPerProcessor   *int64                             `json:"threadsPerProcessor"`
+}
+
+type DedicatedServerNetworkSpecificationsBandwidth struct {
+	InternetToOvh *DedicatedServerSpecificationValue `json:"InternetToOvh"`
+	OvhToInternet *DedicatedServerSpecificationValue `json:"OvhToInternet"`
+	OvhToOvh      *DedicatedServerSpecificationValue `json:"OvhToOvh"`
+	Type          string                             `json:"type"`
+}
+
+func (v *DedicatedServerNetworkSpecificationsBandwidth) ToList() []map[string]interface{} {
+	if v == nil {
+		return []map[string]interface{}{}
+	}
+
+	obj := make(map[string]interface{})
+	obj["internet_to_ovh"] = v.InternetToOvh.ToList()
+	obj["ovh_to_internet"] = v.OvhToInternet.ToList()
+	obj["ovh_to_ovh"] = v.OvhToOvh.ToList()
+	obj["type"] = v.Type
+	return []map[string]interface{}{obj}
+}
+
+type DedicatedServerNetworkSpecificationsVrack struct {
+	Bandwidth *DedicatedServerSpecificationValue `json:"bandwidth"`
+	Type      string                             `json:"type"`
+}
+
+func (v *DedicatedServerNetworkSpecificationsVrack) ToList() []map[string]interface{} {
+	if v == nil {
+		return []map[string]interface{}{}
+	}
+
+	obj := make(map[string]interface{})
+	obj["bandwidth"] = v.Bandwidth.ToList()
+	obj["type"] = v.Type
+	return []map[string]interface{}{obj}
+}
+
+type DedicatedServerNetworkSpecificationsRoutingIp struct {
+	Gateway *string `json:"gateway"`
+	Ip      *string `json:"ip"`
+	Network *string `json:"network"`
+}
+
+func (v *DedicatedServerNetworkSpecificationsRoutingIp) ToList() []map[string]interface{} {
+	if v == nil {
+		return []map[string]interface{}{}
+	}
+
+	obj := make(map[string]interface{})
+	if v.Gateway != nil {
+		obj["gateway"] = *v.Gateway
+	}
+	if v.Ip != nil {
+		obj["ip"] = *v.Ip
+	}
+	if v.Network != nil {
+		obj["network"] = *v.Network
+	}
+	return []map[string]interface{}{obj}
+}
+
+type DedicatedServerNetworkSpecificationsRouting struct {
+	Ipv4 *DedicatedServerNetworkSpecificationsRoutingIp `json:"ipv4"`
+	Ipv6 *DedicatedServerNetworkSpecificationsRoutingIp `json:"ipv6"`
+}
+
+func (v *DedicatedServerNetworkSpecificationsRouting) ToList() []map[string]interface{} {
+	if v == nil {
+		return []map[string]interface{}{}
+	}
+
+	obj := make(map[string]interface{})
+	obj["ipv4"] = v.Ipv4.ToList()
+	obj["ipv6"] = v.Ipv6.ToList()
+	return []map[string]interface{}{obj}
+}
+
+type DedicatedServerNetworkSpecificationsOla struct {
+	Available      bool     `json:"available"`
+	AvailableModes []string `json:"availableModes"`
+	SupportedModes []string `json:"supportedModes"`
+}
+
+func (v *DedicatedServerNetworkSpecificationsOla) ToList() []map[string]interface{} {
+	if v == nil {
+		return []map[string]interface{}{}
+	}
+
+	obj := make(map[string]interface{})
+	obj["available"] = v.Available
+	obj["available_modes"] = v.AvailableModes
+	obj["supported_modes"] = v.SupportedModes
+	return []map[string]interface{}{obj}
+}
+
+type DedicatedServerNetworkSpecifications struct {
+	Bandwidth     *DedicatedServerNetworkSpecificationsBandwidth `json:"bandwidth"`
+	ConnectionVal *DedicatedServerSpecificationValue             `json:"connection_val"`
+	Ola           *DedicatedServerNetworkSpecificationsOla       `json:"ola"`
+	Routing       *DedicatedServerNetworkSpecificationsRouting   `json:"routing"`
+	Vrack         *DedicatedServerNetworkSpecificationsVrack     `json:"vrack"`
+}
+
+type DedicatedServerIpmiAccess struct {
+	Expiration string `json:"expiration"`
+	Value      string `json:"value"`
+}
+
+type DedicatedServerIpmiAccessOpts struct {
+	IpToAllow *string `json:"ipToAllow,omitempty"`
+	SshKey    *string `json:"sshKey,omitempty"`
+	Ttl       int     `json:"ttl"`
+	Type      string  `json:"type"`
+}
+
+func (opts *DedicatedServerIpmiAccessOpts) FromResource(d *schema.ResourceData) *DedicatedServerIpmiAccessOpts {
+	opts.IpToAllow = helpers.GetNilStringPointerFromData(d, "ip_to_allow")
+	opts.SshKey = helpers.GetNilStringPointerFromData(d, "ssh_key")
+	opts.Ttl = d.Get("ttl").(int)
+	opts.Type = d.Get("type").(string)
+	return opts
+}
+
+type DedicatedServerBackupFtp struct {
+	FtpBackupName string                             `json:"ftpBackupName"`
+	Quota         *DedicatedServerSpecificationValue `json:"quota"`
+	ReadOnlyDate  *string                            `json:"readOnlyDate"`
+	Type          string                             `json:"type"`
+	Usage         *DedicatedServerSpecificationValue `json:"usage"`
+}
+
+type DedicatedServerBackupFtpAccess struct {
+	Cifs       bool   `json:"cifs"`
+	Ftp        bool   `json:"ftp"`
+	IpBlock    string `json:"ipBlock"`
+	IsApplied  bool   `json:"isApplied"`
+	LastUpdate string `json:"lastUpdate"`
+	Nfs        bool   `json:"nfs"`
+}
+
+type DedicatedServerBackupFtpAccessCreateOpts struct {
+	Cifs    bool   `json:"cifs"`
+	Ftp     bool   `json:"ftp"`
+	IpBlock string `json:"ipBlock"`
+	Nfs     bool   `json:"nfs"`
+}
+
+func (opts *DedicatedServerBackupFtpAccessCreateOpts) FromResource(d *schema.ResourceData) *DedicatedServerBackupFtpAccessCreateOpts {
+	opts.Cifs = d.Get("cifs").(bool)
+	opts.Ftp = d.Get("ftp").(bool)
+	opts.IpBlock = d.Get("ip_block").(string)
+	opts.Nfs = d.Get("nfs").(bool)
+	return opts
+}
+
+type DedicatedServerBackupFtpAccessUpdateOpts struct {
+	Cifs bool `json:"cifs"`
+	Ftp  bool `json:"ftp"`
+	Nfs  bool `json:"nfs"`
+}
+
+func (opts *DedicatedServerBackupFtpAccessUpdateOpts) FromResource(d *schema.ResourceData) *DedicatedServerBackupFtpAccessUpdateOpts {
+	opts.Cifs = d.Get("cifs").(bool)
+	opts.Ftp = d.Get("ftp").(bool)
+	opts.Nfs = d.Get("nfs").(bool)
+	return opts
+}
+
+type DedicatedServerServiceMonitoring struct {
+	ChallengeText *string `json:"challengeText"`
+	Enabled       bool    `json:"enabled"`
+	Interval      string  `json:"interval"`
+	Ip            string  `json:"ip"`
+	MonitoringId  int64   `json:"monitoringId"`
+	Port          int     `json:"port"`
+	Protocol      string  `json:"protocol"`
+	Url           *string `json:"url"`
+}
+
+type DedicatedServerServiceMonitoringCreateOpts struct {
+	ChallengeText *string `json:"challengeText,omitempty"`
+	Interval      string  `json:"interval"`
+	Ip            string  `json:"ip"`
+	Port          int     `json:"port"`
+	Protocol      string  `json:"protocol"`
+	Url           *string `json:"url,omitempty"`
+}
+
+func (opts *DedicatedServerServiceMonitoringCreateOpts) FromResource(d *schema.ResourceData) *DedicatedServerServiceMonitoringCreateOpts {
+	opts.ChallengeText = helpers.GetNilStringPointerFromData(d, "challenge_text")
+	opts.Interval = d.Get("interval").(string)
+	opts.Ip = d.Get("ip").(string)
+	opts.Port = d.Get("port").(int)
+	opts.Protocol = d.Get("protocol").(string)
+	opts.Url = helpers.GetNilStringPointerFromData(d, "url")
+	return opts
+}
+
+type DedicatedServerServiceMonitoringUpdateOpts struct {
+	ChallengeText *string `json:"challengeText,omitempty"`
+	Enabled       bool    `json:"enabled"`
+	Interval      string  `json:"interval"`
+	Ip            string  `json:"ip"`
+	Port          int     `json:"port"`
+	Protocol      string  `json:"protocol"`
+	Url           *string `json:"url,omitempty"`
+}
+
+func (opts *DedicatedServerServiceMonitoringUpdateOpts) FromResource(d *schema.ResourceData) *DedicatedServerServiceMonitoringUpdateOpts {
+	opts.ChallengeText = helpers.GetNilStringPointerFromData(d, "challenge_text")
+	opts.Enabled = d.Get("enabled").(bool)
+	opts.Interval = d.Get("interval").(string)
+	opts.Ip = d.Get("ip").(string)
+	opts.Port = d.Get("port").(int)
+	opts.Protocol = d.Get("protocol").(string)
+	opts.Url = helpers.GetNilStringPointerFromData(d, "url")
+	return opts
+}
+
+type DedicatedServerServiceMonitoringAlertEmail struct {
+	AlertId  int64  `json:"alertId"`
+	Email    string `json:"email"`
+	Language string `json:"language"`
+}
+
+type DedicatedServerServiceMonitoringAlertEmailOpts struct {
+	Email    string `json:"email"`
+	Language string `json:"language"`
+}
+
+func (opts *DedicatedServerServiceMonitoringAlertEmailOpts) FromResource(d *schema.ResourceData) *DedicatedServerServiceMonitoringAlertEmailOpts {
+	opts.Email = d.Get("email").(string)
+	opts.Language = d.Get("language").(string)
+	return opts
+}
+
+type DedicatedServerServiceMonitoringAlertSms struct {
+	AlertId       int64  `json:"alertId"`
+	Enabled       bool   `json:"enabled"`
+	FromHour      *int   `json:"fromHour"`
+	Language      string `json:"language"`
+	PhoneNumberTo string `json:"phoneNumberTo"`
+	SmsAccount    string `json:"smsAccount"`
+	ToHour        *int   `json:"toHour"`
+}
+
+type DedicatedServerServiceMonitoringAlertSmsCreateOpts struct {
+	FromHour      *int   `json:"fromHour,omitempty"`
+	Language      string `json:"language"`
+	PhoneNumberTo string `json:"phoneNumberTo"`
+	SmsAccount    string `json:"smsAccount"`
+	ToHour        *int   `json:"toHour,omitempty"`
+}
+
+func (opts *DedicatedServerServiceMonitoringAlertSmsCreateOpts) FromResource(d *schema.ResourceData) *DedicatedServerServiceMonitoringAlertSmsCreateOpts {
+	opts.FromHour, opts.ToHour = dedicatedServerServiceMonitoringAlertSmsHours(d)
+	opts.Language = d.Get("language").(string)
+	opts.PhoneNumberTo = d.Get("phone_number_to").(string)
+	opts.SmsAccount = d.Get("sms_account").(string)
+	return opts
+}
+
+type DedicatedServerServiceMonitoringAlertSmsUpdateOpts struct {
+	Enabled  bool   `json:"enabled"`
+	FromHour *int   `json:"fromHour,omitempty"`
+	Language string `json:"language"`
+	ToHour   *int   `json:"toHour,omitempty"`
+}
+
+func (opts *DedicatedServerServiceMonitoringAlertSmsUpdateOpts) FromResource(d *schema.ResourceData) *DedicatedServerServiceMonitoringAlertSmsUpdateOpts {
+	opts.Enabled = d.Get("enabled").(bool)
+	opts.FromHour, opts.ToHour = dedicatedServerServiceMonitoringAlertSmsHours(d)
+	opts.Language = d.Get("language").(string)
+	return opts
+}
+
+// hour 0 is a valid value, GetOk can't be used
+func dedicatedServerServiceMonitoringAlertSmsHours(d *schema.ResourceData) (*int, *int) {
+	var fromHour, toHour *int
+	if v, ok := d.GetOkExists("from_hour"); ok {
+		fromHour = helpers.GetNilIntPointer(v)
+	}
+	if v, ok := d.GetOkExists("to_hour"); ok {
+		toHour = helpers.GetNilIntPointer(v)
+	}
+	return fromHour, toHour
+}
+
+type DedicatedServerSecondaryDnsNameDomainToken struct {
+	Domain    string `json:"domain"`
+	SubDomain string `json:"subDomain"`
+	Token     string `json:"token"`
+}
+
+type DedicatedServerSecondaryDnsDomain struct {
+	CreationDate string `json:"creationDate"`
+	Dns          string `json:"dns"`
+	Domain       string `json:"domain"`
+	IpMaster     string `json:"ipMaster"`
+}
+
+type DedicatedServerSecondaryDnsDomainCreateOpts struct {
+	Domain string  `json:"domain"`
+	Ip     *string `json:"ip,omitempty"`
+}
+
+func (opts *DedicatedServerSecondaryDnsDomainCreateOpts) FromResource(d *schema.ResourceData) *DedicatedServerSecondaryDnsDomainCreateOpts {
+	opts.Domain = d.Get("domain").(string)
+	opts.Ip = helpers.GetNilStringPointerFromData(d, "ip_master")
+	return opts
+}
+
+type DedicatedServerSecondaryDnsDomainUpdateOpts struct {
+	IpMaster string `json:"ipMaster"`
+}
+
+type DedicatedServerSecondaryDnsServer struct {
+	Dns      string `json:"dns"`
+	Hostname string `json:"hostname"`
+	Ip       string `json:"ip"`
+}
+
+type DedicatedServerHardwareRaidDisk struct {
+	Capacity   *DedicatedServerSpecificationValue `json:"capacity"`
+	Names      []string                           `json:"names"`
+	Number     int64                              `json:"number"`
+	Speed      *DedicatedServerSpecificationValue `json:"speed"`
+	Technology string                             `json:"technology"`
+}
+
+func (v DedicatedServerHardwareRaidDisk) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["capacity"] = v.Capacity.ToList()
+	obj["names"] = v.Names
+	obj["number"] = v.Number
+	obj["speed"] = v.Speed.ToList()
+	obj["technology"] = v.Technology
+	return obj
+}
+
+type DedicatedServerHardwareRaidController struct {
+	Disks []DedicatedServerHardwareRaidDisk `json:"disks"`
+	Model string                            `json:"model"`
+	Type  string                            `json:"type"`
+}
+
+func (v DedicatedServerHardwareRaidController) ToMap() map[string]interface{} {
+	disks := make([]map[string]interface{}, len(v.Disks))
+	for i, disk := range v.Disks {
+		disks[i] = disk.ToMap()
+	}
+
+	obj := make(map[string]interface{})
+	obj["disks"] = disks
+	obj["model"] = v.Model
+	obj["type"] = v.Type
+	return obj
+}
+
+type DedicatedServerHardwareRaidProfile struct {
+	Controllers []DedicatedServerHardwareRaidController `json:"controllers"`
+}
+
+type DedicatedServerHardwareRaidSize struct {
+	Capacity *DedicatedServerSpecificationValue `json:"capacity"`
+}
@@ -0,0 +1,260 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DomainTask struct {
+	Id           int64     `json:"id"`
+	Function     string    `json:"function"`
+	Status       string    `json:"status"`
+	Comment      *string   `json:"comment"`
+	CreationDate time.Time `json:"creationDate"`
+	DoneDate     time.Time `json:"doneDate"`
+	LastUpdate   time.Time `json:"lastUpdate"`
+	TodoDate     time.Time `json:"todoDate"`
+}
+
+func (t DomainTask) String() string {
+	return fmt.Sprintf(
+		"id: %v, function: %v, status: %v",
+		t.Id,
+		t.Function,
+		t.Status,
+	)
+}
+
+type DomainNameServer struct {
+	Id       int64   `json:"id"`
+	Host     string  `json:"host"`
+	Ip       *string `json:"ip"`
+	IsUsed   bool    `json:"isUsed"`
+	ToDelete bool    `json:"toDelete"`
+}
+
+func (v DomainNameServer) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["host"] = v.Host
+
+	if v.Ip != nil {
+		obj["ip"] = *v.Ip
+	}
+
+	return obj
+}
+
+type DomainNameServerOpts struct {
+	Host string  `json:"host"`
+	Ip   *string `json:"ip,omitempty"`
+}
+
+type DomainNameServersUpdateOpts struct {
+	NameServers []DomainNameServerOpts `json:"nameServers"`
+}
+
+func (opts *DomainNameServersUpdateOpts) FromResource(d *schema.ResourceData) *DomainNameServersUpdateOpts {
+	servers := d.Get("servers").(*schema.Set).List()
+	opts.NameServers = make([]DomainNameServerOpts, len(servers))
+
+	for i, server := range servers {
+		s := server.(map[string]interface{})
+		opts.NameServers[i] = DomainNameServerOpts{
+			Host: s["host"].(string),
+		}
+
+		if ip, ok := s["ip"]; ok && ip.(string) != "" {
+			value := ip.(string)
+			opts.NameServers[i].Ip = &value
+		}
+	}
+
+	return opts
+}
+
+type DomainGlueRecord struct {
+	Host string   `json:"host"`
+	Ips  []string `json:"ips"`
+}
+
+type DomainGlueRecordCreateOpts struct {
+	Host string   `json:"host"`
+	Ips  []string `json:"ips"`
+}
+
+func (opts *DomainGlueRecordCreateOpts) FromResource(d *schema.ResourceData) *DomainGlueRecordCreateOpts {
+	opts.Host = d.Get("host").(string)
+	opts.Ips = domainGlueRecordIpsFromResource(d)
+	return opts
+}
+
+type DomainGlueRecordUpdateOpts struct {
+	Ips []string `json:"ips"`
+}
+
+func (opts *DomainGlueRecordUpdateOpts) FromResource(d *schema.ResourceData) *DomainGlueRecordUpdateOpts {
+	opts.Ips = domainGlueRecordIpsFromResource(d)
+	return opts
+}
+
+func domainGlueRecordIpsFromResource(d *schema.ResourceData) []string {
+	ips := d.Get("ips").(*schema.Set).List()
+	result := make([]string, len(ips))
+	for i, ip := range ips {
+		result[i] = ip.(string)
+	}
+	return result
+}
+
+type DomainZoneDynHostRecord struct {
+	Id        int64  `json:"id"`
+	Ip        string `json:"ip"`
+	SubDomain string `json:"subDomain"`
+	Ttl       int    `json:"ttl"`
+	Zone      string `json:"zone"`
+}
+
+func (r DomainZoneDynHostRecord) String() string {
+	return fmt.Sprintf(
+		"dynhost record[id: %v, zone: %s, subdomain: %s, ip: %s]",
+		r.Id,
+		r.Zone,
+		r.SubDomain,
+		r.Ip,
+	)
+}
+
+type DomainZoneDynHostRecordOpts struct {
+	Ip        string `json:"ip"`
+	SubDomain string `json:"subDomain"`
+}
+
+func (opts *DomainZoneDynHostRecordOpts) FromResource(d *schema.ResourceData) *DomainZoneDynHostRecordOpts {
+	opts.Ip = d.Get("ip").(string)
+	opts.SubDomain = d.Get("subdomain").(string)
+	return opts
+}
+
+type DomainZoneDynHostLogin struct {
+	Login     string `json:"login"`
+	SubDomain string `json:"subDomain"`
+	Zone      string `json:"zone"`
+}
+
+type DomainZoneDynHostLoginCreateOpts struct {
+	LoginSuffix string `json:"loginSuffix"`
+	Password    string `json:"password"`
+	SubDomain   string `json:"subDomain"`
+}
+
+func (opts *DomainZoneDynHostLoginCreateOpts) FromResource(d *schema.ResourceData) *DomainZoneDynHostLoginCreateOpts {
+	opts.LoginSuffix = d.Get("login_suffix").(string)
+	opts.Password = d.Get("password").(string)
+	opts.SubDomain = d.Get("subdomain").(string)
+	return opts
+}
+
+type DomainZoneDynHostLoginUpdateOpts struct {
+	SubDomain string `json:"subDomain"`
+}
+
+func (opts *DomainZoneDynHostLoginUpdateOpts) FromResource(d *schema.ResourceData) *DomainZoneDynHostLoginUpdateOpts {
+	opts.SubDomain = d.Get("subdomain").(string)
+	return opts
+}
+
+type DomainZoneDynHostLoginChangePasswordOpts struct {
+	Password string `json:"password"`
+}
+
+type DomainZoneSoa struct {
+	Email       string `json:"email"`
+	Expire      int    `json:"expire"`
+	NxDomainTtl int    `json:"nxDomainTtl"`
+	Refresh     int    `json:"refresh"`
+	Serial      int64  `json:"serial,omitempty"`
+	Server      string `json:"server,omitempty"`
+	Ttl         int    `json:"ttl"`
+}
+
+func (opts *DomainZoneSoa) FromResource(d *schema.ResourceData) *DomainZoneSoa {
+	if v, ok := d.GetOk("email"); ok {
+		opts.Email = v.(string)
+	}
+	if v, ok := d.GetOk("expire"); ok {
+		opts.Expire = v.(int)
+	}
+	if v, ok := d.GetOk("nx_domain_ttl"); ok {
+		opts.NxDomainTtl = v.(int)
+	}
+	if v, ok := d.GetOk("refresh"); ok {
+		opts.Refresh = v.(int)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		opts.Ttl = v.(int)
+	}
+
+	// serial and server are read only
+	opts.Serial = 0
+	opts.Server = ""
+	return opts
+}
+
+type DomainZoneResetRecord struct {
+	FieldType string `json:"fieldType"`
+	Target    string `json:"target"`
+}
+
+type DomainZoneResetOpts struct {
+	DnsRecords []DomainZoneResetRecord `json:"DnsRecords,omitempty"`
+	Minimized  bool                    `json:"minimized"`
+}
+
+func (opts *DomainZoneResetOpts) FromResource(d *schema.ResourceData) *DomainZoneResetOpts {
+	opts.Minimized = d.Get("reset_minimized").(bool)
+
+	records := d.Get("reset_records").([]interface{})
+	opts.DnsRecords = make([]DomainZoneResetRecord, len(records))
+	for i, record := range records {
+		r := record.(map[string]interface{})
+		opts.DnsRecords[i] = DomainZoneResetRecord{
+			FieldType: r["fieldtype"].(string),
+			Target:    r["target"].(string),
+		}
+	}
+
+	return opts
+}
+
+type Domain struct {
+	Domain                     string `json:"domain"`
+	DnssecSupported            bool   `json:"dnssecSupported"`
+	GlueRecordIpv6Supported    bool   `json:"glueRecordIpv6Supported"`
+	GlueRecordMultiIpSupported bool   `json:"glueRecordMultiIpSupported"`
+	LastUpdate                 string `json:"lastUpdate"`
+	NameServerType             string `json:"nameServerType"`
+	Offer                      string `json:"offer"`
+	OwoSupported               bool   `json:"owoSupported"`
+	TransferLockStatus         string `json:"transferLockStatus"`
+	WhoisOwner                 string `json:"whoisOwner"`
+}
+
+func (v Domain) String() string {
+	return fmt.Sprintf(
+		"domain: %v, nameServerType: %v, transferLockStatus: %v",
+		v.Domain,
+		v.NameServerType,
+		v.TransferLockStatus,
+	)
+}
+
+type DomainUpdateOpts struct {
+	TransferLockStatus string `json:"transferLockStatus"`
+}
+
+type DomainZoneRestorePoint struct {
+	CreationDate string `json:"creationDate"`
+	ZoneFileUrl  string `json:"zoneFileUrl"`
+}
@@ -0,0 +1,321 @@
+package ovh
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/ovh/helpers"
+)
+
+type IpFirewall struct {
+	Enabled      bool   `json:"enabled"`
+	IpOnFirewall string `json:"ipOnFirewall"`
+	State        string `json:"state"`
+}
+
+func (v IpFirewall) String() string {
+	return fmt.Sprintf(
+		"ipOnFirewall: %v, enabled: %v, state: %v",
+		v.IpOnFirewall,
+		v.Enabled,
+		v.State,
+	)
+}
+
+type IpFirewallCreateOpts struct {
+	IpOnFirewall string `json:"ipOnFirewall"`
+}
+
+type IpFirewallUpdateOpts struct {
+	Enabled bool `json:"enabled"`
+}
+
+type IpFirewallRule struct {
+	Action          string  `json:"action"`
+	CreationDate    string  `json:"creationDate"`
+	Destination     string  `json:"destination"`
+	DestinationPort *string `json:"destinationPort"`
+	Fragments       *bool   `json:"fragments"`
+	Protocol        string  `json:"protocol"`
+	Rule            string  `json:"rule"`
+	Sequence        int     `json:"sequence"`
+	Source          string  `json:"source"`
+	SourcePort      *string `json:"sourcePort"`
+	State           string  `json:"state"`
+	TcpOption       *string `json:"tcpOption"`
+}
+
+func (v IpFirewallRule) String() string {
+	return fmt.Sprintf(
+		"sequence: %v, rule: %v, state: %v",
+		v.Sequence,
+		v.Rule,
+		v.State,
+	)
+}
+
+func (v IpFirewallRule) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["action"] = v.Action
+	obj["creation_date"] = v.CreationDate
+	obj["destination"] = v.Destination
+	obj["protocol"] = v.Protocol
+	obj["rule"] = v.Rule
+	obj["sequence"] = v.Sequence
+	obj["source"] = v.Source
+	obj["state"] = v.State
+
+	if v.DestinationPort != nil {
+		obj["destination_port"] = ipFirewallRulePort(*v.DestinationPort)
+	}
+	if v.SourcePort != nil {
+		obj["source_port"] = ipFirewallRulePort(*v.SourcePort)
+	}
+	if v.Fragments != nil {
+		obj["fragments"] = *v.Fragments
+	}
+	if v.TcpOption != nil {
+		obj["tcp_option"] = *v.TcpOption
+	}
+
+	return obj
+}
+
+// ipFirewallRulePort converts a port as returned by the API,
+// such as "eq 80", to its numeric value
+func ipFirewallRulePort(port string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(port, "eq")))
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+type IpFirewallRuleTcpOption struct {
+	Option string `json:"option"`
+}
+
+type IpFirewallRuleCreateOpts struct {
+	Action          string                   `json:"action"`
+	DestinationPort *int                     `json:"destinationPort,omitempty"`
+	Fragments       *bool                    `json:"fragments,omitempty"`
+	Protocol        string                   `json:"protocol"`
+	Sequence        int                      `json:"sequence"`
+	Source          *string                  `json:"source,omitempty"`
+	SourcePort      *int                     `json:"sourcePort,omitempty"`
+	TcpOption       *IpFirewallRuleTcpOption `json:"tcpOption,omitempty"`
+}
+
+func (opts *IpFirewallRuleCreateOpts) FromResource(d *schema.ResourceData) *IpFirewallRuleCreateOpts {
+	opts.Action = d.Get("action").(string)
+	opts.Protocol = d.Get("protocol").(string)
+	opts.Sequence = d.Get("sequence").(int)
+	opts.Source = helpers.GetNilStringPointerFromData(d, "source")
+	opts.DestinationPort = helpers.GetNilIntPointerFromData(d, "destination_port")
+	opts.SourcePort = helpers.GetNilIntPointerFromData(d, "source_port")
+
+	if d.Get("fragments").(bool) {
+		opts.Fragments = helpers.GetNilBoolPointer(true)
+	}
+
+	if v, ok := d.GetOk("tcp_option"); ok {
+		opts.TcpOption = &IpFirewallRuleTcpOption{Option: v.(string)}
+	}
+
+	return opts
+}
+
+type IpMitigation struct {
+	Auto           bool   `json:"auto"`
+	IpOnMitigation string `json:"ipOnMitigation"`
+	Permanent      bool   `json:"permanent"`
+	State          string `json:"state"`
+}
+
+func (v IpMitigation) String() string {
+	return fmt.Sprintf(
+		"ipOnMitigation: %v, permanent: %v, auto: %v, state: %v",
+		v.IpOnMitigation,
+		v.Permanent,
+		v.Auto,
+		v.State,
+	)
+}
+
+type IpMitigationCreateOpts struct {
+	IpOnMitigation string `json:"ipOnMitigation"`
+}
+
+type IpMitigationUpdateOpts struct {
+	Permanent bool `json:"permanent"`
+}
+
+type IpMitigationProfile struct {
+	AutoMitigationTimeOut int    `json:"autoMitigationTimeOut"`
+	IpMitigationProfile   string `json:"ipMitigationProfile"`
+	State                 string `json:"state"`
+}
+
+func (v IpMitigationProfile) String() string {
+	return fmt.Sprintf(
+		"ipMitigationProfile: %v, autoMitigationTimeOut: %v, state: %v",
+		v.IpMitigationProfile,
+		v.AutoMitigationTimeOut,
+		v.State,
+	)
+}
+
+type IpMitigationProfileCreateOpts struct {
+	AutoMitigationTimeOut int    `json:"autoMitigationTimeOut"`
+	IpMitigationProfile   string `json:"ipMitigationProfile"`
+}
+
+type IpMitigationProfileUpdateOpts struct {
+	AutoMitigationTimeOut int `json:"autoMitigationTimeOut"`
+}
+
+type IpRoutedTo struct {
+	ServiceName *string `json:"serviceName"`
+}
+
+type Ip struct {
+	Campus          *string    `json:"campus"`
+	CanBeTerminated bool       `json:"canBeTerminated"`
+	Country         *string    `json:"country"`
+	Description     *string    `json:"description"`
+	Ip              string     `json:"ip"`
+	OrganisationId  *string    `json:"organisationId"`
+	RoutedTo        IpRoutedTo `json:"routedTo"`
+	Type            string     `json:"type"`
+}
+
+func (v Ip) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = v.Ip
+	obj["can_be_terminated"] = v.CanBeTerminated
+	obj["type"] = v.Type
+
+	if v.Campus != nil {
+		obj["campus"] = *v.Campus
+	}
+	if v.Country != nil {
+		obj["country"] = *v.Country
+	}
+	if v.Description != nil {
+		obj["description"] = *v.Description
+	}
+	if v.OrganisationId != nil {
+		obj["organisation_id"] = *v.OrganisationId
+	}
+	if v.RoutedTo.ServiceName != nil {
+		obj["routed_to"] = []interface{}{
+			map[string]interface{}{
+				"service_name": *v.RoutedTo.ServiceName,
+			},
+		}
+	}
+
+	return obj
+}
+
+func (v Ip) String() string {
+	routedTo := ""
+	if v.RoutedTo.ServiceName != nil {
+		routedTo = *v.RoutedTo.ServiceName
+	}
+	return fmt.Sprintf(
+		"ip: %v, type: %v, routedTo: %v",
+		v.Ip,
+		v.Type,
+		routedTo,
+	)
+}
+
+type IpTask struct {
+	Id          int64     `json:"taskId"`
+	Function    string    `json:"function"`
+	Comment     *string   `json:"comment"`
+	Destination *string   `json:"destination"`
+	Status      string    `json:"status"`
+	LastUpdate  time.Time `json:"lastUpdate"`
+	DoneDate    time.Time `json:"doneDate"`
+	StartDate   time.Time `json:"startDate"`
+}
+
+type IpDestination struct {
+	Service string  `json:"service"`
+	Nexthop *string `json:"nexthop"`
+}
+
+// IpDestinations lists the services an ip can be moved to,
+// indexed by service type
+type IpDestinations map[string][]IpDestination
+
+type IpMoveOpts struct {
+	To      string  `json:"to"`
+	Nexthop *string `json:"nexthop,omitempty"`
+}
+
+type IpUpdateOpts struct {
+	Description string `json:"description"`
+}
+
+type IpGame struct {
+	FirewallModeEnabled bool   `json:"firewallModeEnabled"`
+	IpOnGame            string `json:"ipOnGame"`
+	State               string `json:"state"`
+}
+
+func (v IpGame) String() string {
+	return fmt.Sprintf(
+		"ipOnGame: %v, firewallModeEnabled: %v, state: %v",
+		v.IpOnGame,
+		v.FirewallModeEnabled,
+		v.State,
+	)
+}
+
+type IpGameUpdateOpts struct {
+	FirewallModeEnabled bool `json:"firewallModeEnabled"`
+}
+
+type IpGameRulePorts struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+type IpGameRule struct {
+	Id       int64           `json:"id"`
+	Ports    IpGameRulePorts `json:"ports"`
+	Protocol string          `json:"protocol"`
+	State    string          `json:"state"`
+}
+
+func (v IpGameRule) String() string {
+	return fmt.Sprintf(
+		"id: %v, protocol: %v, ports: %v-%v, state: %v",
+		v.Id,
+		v.Protocol,
+		v.Ports.From,
+		v.Ports.To,
+		v.State,
+	)
+}
+
+type IpGameRuleCreateOpts struct {
+	Ports    IpGameRulePorts `json:"ports"`
+	Protocol string          `json:"protocol"`
+}
+
+func (opts *IpGameRuleCreateOpts) FromResource(d *schema.ResourceData) *IpGameRuleCreateOpts {
+	opts.Protocol = d.Get("protocol").(string)
+	opts.Ports.From = d.Get("ports_from").(int)
+	opts.Ports.To = opts.Ports.From
+	if v, ok := d.GetOk("ports_to"); ok {
+		opts.Ports.To = v.(int)
+	}
+	return opts
+}